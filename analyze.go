@@ -0,0 +1,132 @@
+package main
+
+import (
+	"log"
+	"os"
+	"slices"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/render"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+)
+
+// runAnalyze runs the analyze command, which prints the reports of every strategy of the given backtest results.
+func runAnalyze(args []string) error {
+	fs := newFlagSet("analyze", "<result file|.last_result.json|backtest results directory>...")
+	g := addGlobalFlags(fs, append(tableFormats, "json")...)
+	g.addLatestFlag(fs)
+	rf := newReportFlags()
+	rf.addScoreFlags(fs)
+	rf.addAnalysisFlags(fs)
+	rf.addBootstrapFlags(fs)
+	pairSort := fs.String("pair-sort", "Tot Profit:desc", "pair report column to sort by, suffixed with :asc or :desc")
+	drawdowns := fs.Int("drawdowns", 5, "number of drawdown episodes to show, 0 to hide the drawdown report")
+	outputDir := fs.String("output-dir", "", "write each table to its own file in this directory instead of stdout")
+	excursions := fs.Bool("excursions", false, "show the MAE/MFE report by exit reason, same as adding excursions to --reports")
+	histograms := fs.Bool("histograms", false, "show profit and duration histograms and percentiles by exit reason, same as adding histograms to --reports")
+	err := g.parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	log.Println("> start")
+
+	opts, err := rf.options()
+	if err != nil {
+		return err
+	}
+	var optional []string
+	if *excursions {
+		optional = append(optional, "excursions")
+	}
+	if *histograms {
+		optional = append(optional, "histograms")
+	}
+	opts.Reports = withReports(opts.Reports, optional...)
+
+	err = g.prepareOutputDir(*outputDir)
+	if err != nil {
+		return err
+	}
+
+	results, err := g.loadResults()
+	if err != nil {
+		return err
+	}
+
+	if g.format == "json" {
+		var jsonOutput render.JSONOutput
+		for _, backtestResult := range results {
+			jsonOutput.Results = append(jsonOutput.Results, jsonResult(backtestResult, opts))
+		}
+
+		return render.WriteJSON(os.Stdout, jsonOutput)
+	}
+
+	renderOpts := render.Options{
+		Format:    g.format,
+		OutputDir: *outputDir,
+		PairSort:  *pairSort,
+		Drawdowns: *drawdowns,
+		Reports:   opts.Reports,
+	}
+	for _, backtestResult := range results {
+		reports := report.Reports(backtestResult, opts)
+		logWarnings(backtestResult, reports)
+		render.Print(backtestResult, reports, renderOpts)
+	}
+
+	return nil
+}
+
+// jsonResult computes the reports of a backtest result
+func jsonResult(backtestResult *backtest.BacktestResult, opts report.Options) render.JSONResult {
+	reports := report.Reports(backtestResult, opts)
+	logWarnings(backtestResult, reports)
+
+	return render.JSONResult{
+		File:       backtestResult.Filename,
+		Summary:    report.Summary(backtestResult, reports),
+		Strategies: reports,
+	}
+}
+
+// logWarnings logs the warnings raised while computing the reports of a backtest result,
+// along with the strategies differing from freqtrade strategy comparison.
+func logWarnings(backtestResult *backtest.BacktestResult, reports []report.StrategyReport) {
+	for _, r := range reports {
+		for _, w := range r.Warnings {
+			log.Printf("> WARNING: %s: %s\n", r.Strategy, w)
+		}
+	}
+
+	for _, s := range report.Summary(backtestResult, reports) {
+		if s.CrossCheck != nil && s.CrossCheck.Mismatch() {
+			log.Printf("> WARNING: %s: strategy_comparison differs from recomputed trades: %s\n", s.Strategy, s.CrossCheck.Describe())
+		}
+	}
+}
+
+// withReports adds the named reports to the selection, in display order when no report was explicitly selected
+func withReports(selection []string, names ...string) []string {
+	if len(names) == 0 {
+		return selection
+	}
+
+	if len(selection) == 0 {
+		for _, d := range report.Definitions() {
+			if !d.Optional || slices.Contains(names, d.Name) {
+				selection = append(selection, d.Name)
+			}
+		}
+		return selection
+	}
+
+	for _, name := range names {
+		if !slices.Contains(selection, name) {
+			selection = append(selection, name)
+		}
+	}
+
+	return selection
+}
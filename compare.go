@@ -0,0 +1,48 @@
+package main
+
+import (
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/render"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+)
+
+// runCompare runs the compare command, which compares every strategy of the given backtest results side by side.
+func runCompare(args []string) error {
+	fs := newFlagSet("compare", "<result file|.last_result.json|backtest results directory>...")
+	g := addGlobalFlags(fs, tableFormats...)
+	g.addLatestFlag(fs)
+	rf := newReportFlags()
+	rf.addScoreFlags(fs)
+	rf.addBootstrapFlags(fs)
+	baseline := fs.String("baseline", "1", "baseline run of the comparison, as a run name or its position")
+	outputDir := fs.String("output-dir", "", "write each table to its own file in this directory instead of stdout")
+	err := g.parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	opts, err := rf.options()
+	if err != nil {
+		return err
+	}
+
+	err = g.prepareOutputDir(*outputDir)
+	if err != nil {
+		return err
+	}
+
+	results, err := g.loadResults()
+	if err != nil {
+		return err
+	}
+
+	comparison, err := report.Compare(report.ComparisonRuns(results), *baseline, opts)
+	if err != nil {
+		return err
+	}
+	render.PrintComparison(comparison, render.Options{
+		Format:    g.format,
+		OutputDir: *outputDir,
+	})
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/render"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+)
+
+// runDiff runs the diff command, which matches the trades of two backtest results and shows what changed.
+// Strategies are paired by name, results holding a single strategy each are paired regardless of their name.
+func runDiff(args []string) error {
+	fs := newFlagSet("diff", "<result A> <result B>")
+	g := addGlobalFlags(fs, tableFormats...)
+	limit := fs.Int("limit", 20, "number of trades to list in each detail table, 0 lists all")
+	outputDir := fs.String("output-dir", "", "write each table to its own file in this directory instead of stdout")
+	err := g.parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(g.inputs) != 2 {
+		return fmt.Errorf("expecting 2 inputs got %d", len(g.inputs))
+	}
+
+	err = g.prepareOutputDir(*outputDir)
+	if err != nil {
+		return err
+	}
+
+	results, err := g.loadResults()
+	if err != nil {
+		return err
+	}
+	if len(results) != 2 {
+		return fmt.Errorf("expecting 2 backtest results with selected strategies got %d", len(results))
+	}
+	a, b := results[0], results[1]
+
+	type pair struct{ a, b string }
+	var pairs []pair
+	if len(a.Strategy) == 1 && len(b.Strategy) == 1 {
+		pairs = append(pairs, pair{a: a.StrategyNames()[0], b: b.StrategyNames()[0]})
+	} else {
+		for _, name := range a.StrategyNames() {
+			if _, ok := b.Strategy[name]; ok {
+				pairs = append(pairs, pair{a: name, b: name})
+			} else {
+				log.Printf("> WARNING: strategy %s not found in %s\n", name, b.Name())
+			}
+		}
+		for _, name := range b.StrategyNames() {
+			if _, ok := a.Strategy[name]; !ok {
+				log.Printf("> WARNING: strategy %s not found in %s\n", name, a.Name())
+			}
+		}
+	}
+	if len(pairs) == 0 {
+		return fmt.Errorf("no strategy in common between %s and %s", a.Name(), b.Name())
+	}
+
+	for _, p := range pairs {
+		diff := report.DiffTrades(a.Strategy[p.a], b.Strategy[p.b])
+		render.PrintTradeDiff(diff, fmt.Sprintf("%s (%s)", p.a, a.Name()), fmt.Sprintf("%s (%s)", p.b, b.Name()), *limit, render.Options{
+			Format:    g.format,
+			OutputDir: *outputDir,
+		})
+	}
+
+	return nil
+}
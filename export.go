@@ -0,0 +1,57 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/render"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+)
+
+// runExport runs the export command, which writes the trades of every strategy of the given backtest results
+// along with their derived metrics.
+func runExport(args []string) error {
+	fs := newFlagSet("export", "<result file|.last_result.json|backtest results directory>...")
+	g := addGlobalFlags(fs, "csv", "tsv", "json")
+	g.addLatestFlag(fs)
+	out := fs.String("out", "", "file to write the trades to, defaults to stdout")
+	err := g.parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	results, err := g.loadResults()
+	if err != nil {
+		return err
+	}
+
+	var records []report.TradeRecord
+	for _, backtestResult := range results {
+		for _, name := range backtestResult.StrategyNames() {
+			records = append(records, report.TradeRecords(name, backtestResult.Strategy[name])...)
+		}
+	}
+
+	if *out == "" {
+		return render.WriteTrades(os.Stdout, records, g.format)
+	}
+
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+
+	err = render.WriteTrades(f, records, g.format)
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+	log.Printf("> wrote %d trades to %s\n", len(records), *out)
+
+	return nil
+}
@@ -0,0 +1,406 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/score"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// tableFormats are the formats supported by commands rendering tables
+var tableFormats = []string{"table", "csv", "markdown", "html", "tsv"}
+
+// stringList is a flag which can be repeated, values are also split on commas
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+
+	return nil
+}
+
+// newFlagSet returns a FlagSet for the command name, synopsis describes its arguments
+func newFlagSet(name, synopsis string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s %s [flags] %s\n\nFlags:\n", os.Args[0], name, synopsis)
+		fs.PrintDefaults()
+	}
+
+	return fs
+}
+
+// globalOptions holds the flags shared by every command
+type globalOptions struct {
+	inputs     stringList
+	strategies stringList
+	latest     int
+	format     string
+	formats    []string
+	color      string
+	quiet      bool
+	verbose    bool
+	timezone   string
+
+	// location is the timezone dates are converted to, dates are left untouched when nil
+	location *time.Location
+}
+
+// addGlobalFlags registers the global flags on fs, formats are the output formats
+// supported by the command, the first one being the default.
+func addGlobalFlags(fs *flag.FlagSet, formats ...string) *globalOptions {
+	g := &globalOptions{
+		formats: formats,
+	}
+
+	fs.Var(&g.inputs, "input", "input file or directory, can be repeated, positional arguments are inputs too")
+	fs.Var(&g.strategies, "strategy", "only keep strategies matching the given name or glob pattern (e.g. 'Sample*'), can be repeated")
+	fs.StringVar(&g.format, "format", formats[0], fmt.Sprintf("output format, %s", strings.Join(formats, "|")))
+	fs.StringVar(&g.color, "color", "auto", "colorize output, auto|always|never")
+	fs.BoolVar(&g.quiet, "quiet", false, "only log warnings and errors")
+	fs.BoolVar(&g.verbose, "verbose", false, "log debug messages")
+	fs.StringVar(&g.timezone, "timezone", "", "timezone used to display dates and group periods, e.g. UTC, Local or Europe/Paris, defaults to the timezone of the result file")
+
+	return g
+}
+
+// addLatestFlag registers the --latest flag on fs
+func (g *globalOptions) addLatestFlag(fs *flag.FlagSet) {
+	fs.IntVar(&g.latest, "latest", 0, "use the N most recent results when input is a backtest results directory")
+}
+
+// parse parses args and applies the global flags
+func (g *globalOptions) parse(fs *flag.FlagSet, args []string) error {
+	fs.Parse(args)
+	g.inputs = append(g.inputs, fs.Args()...)
+
+	if !slices.Contains(g.formats, g.format) {
+		return fmt.Errorf("unknown format %q, expecting %s", g.format, strings.Join(g.formats, "|"))
+	}
+
+	for _, pattern := range g.strategies {
+		_, err := backtest.MatchStrategy([]string{pattern}, "")
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.quiet && g.verbose {
+		return fmt.Errorf("--quiet and --verbose are mutually exclusive")
+	}
+	if g.verbose {
+		log.SetFlags(log.LstdFlags | log.Lmicroseconds)
+	}
+	log.SetOutput(&logWriter{
+		w:       os.Stderr,
+		quiet:   g.quiet,
+		verbose: g.verbose,
+	})
+
+	switch g.color {
+	case "always":
+		text.EnableColors()
+	case "never":
+		text.DisableColors()
+	case "auto":
+		// colors are only meant for terminals
+		if g.format != "table" || !isTerminal(os.Stdout) {
+			text.DisableColors()
+		}
+	default:
+		return fmt.Errorf("unknown color mode %q, expecting auto|always|never", g.color)
+	}
+
+	if g.timezone != "" {
+		loc, err := time.LoadLocation(g.timezone)
+		if err != nil {
+			return fmt.Errorf("invalid timezone %q: %w", g.timezone, err)
+		}
+		g.location = loc
+	}
+
+	return nil
+}
+
+// prepareOutputDir creates the directory tables are written to, colors are disabled
+// unless explicitly requested as files are not terminals.
+func (g *globalOptions) prepareOutputDir(dir string) error {
+	if dir == "" {
+		return nil
+	}
+	if g.format == "json" {
+		return fmt.Errorf("--output-dir is not supported with --format json, tables are the only output written to a directory")
+	}
+
+	if g.color == "auto" {
+		text.DisableColors()
+	}
+
+	return os.MkdirAll(dir, 0755)
+}
+
+// loadResults loads the backtest results of every input, keeping only the selected strategies.
+// Results without any selected strategy are skipped.
+func (g *globalOptions) loadResults() ([]*backtest.BacktestResult, error) {
+	if len(g.inputs) < 1 {
+		return nil, fmt.Errorf("expecting at least 1 input got %d", len(g.inputs))
+	}
+
+	var filenames []string
+	for _, input := range g.inputs {
+		inputFilenames, err := backtest.ResolveInputs(input, g.latest)
+		if err != nil {
+			return nil, err
+		}
+		filenames = append(filenames, inputFilenames...)
+	}
+	log.Printf("> DEBUG: resolved %d backtest results from %s\n", len(filenames), strings.Join(g.inputs, ", "))
+
+	// matched is built per call, results are loaded concurrently by the serve command
+	matched := make(map[string]bool)
+	var results []*backtest.BacktestResult
+	for _, filename := range filenames {
+		backtestResult, err := g.loadResult(filename, matched)
+		if err != nil {
+			return nil, err
+		}
+		if len(backtestResult.Strategy) == 0 {
+			log.Printf("> WARNING: no strategy selected in %s\n", filename)
+			continue
+		}
+		results = append(results, backtestResult)
+	}
+
+	g.warnUnmatched(matched)
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no strategy matching %s", g.strategies.String())
+	}
+
+	return results, nil
+}
+
+// loadResult loads a backtest result, keeping only the selected strategies and converting dates to the selected timezone.
+// The strategy patterns matching a strategy of the result are recorded in matched.
+func (g *globalOptions) loadResult(filename string, matched map[string]bool) (*backtest.BacktestResult, error) {
+	backtestResult, err := backtest.LoadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("> loaded backtest result %s\n", filename)
+
+	for _, pattern := range g.strategies {
+		for name := range backtestResult.Strategy {
+			if ok, _ := backtest.MatchStrategy([]string{pattern}, name); ok {
+				matched[pattern] = true
+			}
+		}
+	}
+
+	err = backtestResult.FilterStrategies(g.strategies)
+	if err != nil {
+		return nil, err
+	}
+	if g.location != nil {
+		backtestResult.In(g.location)
+	}
+
+	return backtestResult, nil
+}
+
+// warnUnmatched warns about the strategy patterns which did not match any strategy of the loaded results
+func (g *globalOptions) warnUnmatched(matched map[string]bool) {
+	for _, pattern := range g.strategies {
+		if !matched[pattern] {
+			log.Printf("> WARNING: no strategy matching %s\n", pattern)
+		}
+	}
+}
+
+// isTerminal returns whether f is a terminal
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// logWriter filters log messages by level, messages prefixed with "> " are informational,
+// "> DEBUG: " are only shown when verbose and "> WARNING" are always shown as well as errors.
+type logWriter struct {
+	w       io.Writer
+	quiet   bool
+	verbose bool
+}
+
+func (lw *logWriter) Write(p []byte) (int, error) {
+	// skip the date and time written by the logger
+	message := p
+	if fields := bytes.SplitN(p, []byte(" "), 3); len(fields) == 3 {
+		message = fields[2]
+	}
+
+	switch {
+	case bytes.HasPrefix(message, []byte("> DEBUG: ")):
+		if !lw.verbose {
+			return len(p), nil
+		}
+	case bytes.HasPrefix(message, []byte("> WARNING")):
+	case bytes.HasPrefix(message, []byte("> ")):
+		if lw.quiet {
+			return len(p), nil
+		}
+	}
+
+	return lw.w.Write(p)
+}
+
+// reportFlags holds the flags used to compute reports, commands only register the groups they use
+type reportFlags struct {
+	reports        string
+	scoreConfig    string
+	breakdown      string
+	roiTable       string
+	stoplossSweep  string
+	trailingStop   string
+	monteCarlo     int
+	monteCarloMode string
+	bootstrap      int
+	confidence     float64
+	seed           uint64
+}
+
+// newReportFlags returns reportFlags holding the default values
+func newReportFlags() *reportFlags {
+	return &reportFlags{
+		monteCarloMode: report.ResampleShuffle,
+		confidence:     0.95,
+	}
+}
+
+// addScoreFlags registers the scoring flags on fs
+func (rf *reportFlags) addScoreFlags(fs *flag.FlagSet) {
+	fs.StringVar(&rf.scoreConfig, "score-config", "", "scoring model file (YAML or JSON), defaults to the built-in model")
+}
+
+// addAnalysisFlags registers the report selection, breakdown and simulation flags on fs
+func (rf *reportFlags) addAnalysisFlags(fs *flag.FlagSet) {
+	fs.StringVar(&rf.reports, "reports", "", fmt.Sprintf("reports to show in order, comma separated list of %s or all, defaults to every report but excursions and histograms", strings.Join(report.Names(), "|")))
+	fs.StringVar(&rf.breakdown, "breakdown", "", "show profit breakdown per period, comma separated list of day|week|month|year")
+	fs.StringVar(&rf.roiTable, "roi-table", "", "alternative minimal_roi table to simulate, as minutes:value pairs (e.g. 0:0.05,30:0.02), a JSON object or a JSON file")
+	fs.StringVar(&rf.stoplossSweep, "stoploss-sweep", "", "stoploss values to simulate, as a list (e.g. -0.02,-0.05) or a start:end:step range (e.g. -0.3:-0.02:0.02)")
+	fs.StringVar(&rf.trailingStop, "trailing-stop", "", "trailing stop to simulate, as positive:offset (e.g. 0.01:0.02)")
+	fs.IntVar(&rf.monteCarlo, "montecarlo", 0, "number of Monte Carlo simulations of the trade sequence, 0 disables them")
+	fs.StringVar(&rf.monteCarloMode, "montecarlo-mode", rf.monteCarloMode, "Monte Carlo resampling mode, shuffle|bootstrap")
+}
+
+// addBootstrapFlags registers the bootstrap flags on fs
+func (rf *reportFlags) addBootstrapFlags(fs *flag.FlagSet) {
+	fs.IntVar(&rf.bootstrap, "bootstrap", 0, "number of bootstrap samples used for Sharpe, Sortino, expectancy and score confidence intervals, 0 disables them")
+	fs.Float64Var(&rf.confidence, "confidence", rf.confidence, "confidence level of bootstrap intervals")
+	fs.Uint64Var(&rf.seed, "seed", 0, "random seed used for simulations, 0 picks a random seed")
+}
+
+// scoreModel returns the scoring model from --score-config, or the built-in model
+func (rf *reportFlags) scoreModel() (score.Model, error) {
+	if rf.scoreConfig == "" {
+		return score.Default, nil
+	}
+
+	m, err := score.Load(rf.scoreConfig)
+	if err != nil {
+		return score.Model{}, err
+	}
+	log.Printf("> loaded scoring model from %s\n", rf.scoreConfig)
+
+	return m, nil
+}
+
+// options validates the flags and returns the matching report options
+func (rf *reportFlags) options() (report.Options, error) {
+	scoreModel, err := rf.scoreModel()
+	if err != nil {
+		return report.Options{}, err
+	}
+
+	reports, err := report.ParseSelection(rf.reports)
+	if err != nil {
+		return report.Options{}, err
+	}
+
+	breakdowns, err := report.ParseBreakdownPeriods(rf.breakdown)
+	if err != nil {
+		return report.Options{}, err
+	}
+
+	var roi map[string]float64
+	if rf.roiTable != "" {
+		roi, err = report.ParseROITable(rf.roiTable)
+		if err != nil {
+			return report.Options{}, err
+		}
+	}
+
+	stoplosses, err := report.ParseStoplossSweep(rf.stoplossSweep)
+	if err != nil {
+		return report.Options{}, err
+	}
+
+	trailing, err := report.ParseTrailingStop(rf.trailingStop)
+	if err != nil {
+		return report.Options{}, err
+	}
+
+	if rf.monteCarloMode != report.ResampleShuffle && rf.monteCarloMode != report.ResampleBootstrap {
+		return report.Options{}, fmt.Errorf("unknown Monte Carlo mode %q, expecting shuffle|bootstrap", rf.monteCarloMode)
+	}
+	if rf.confidence <= 0 || rf.confidence >= 1 {
+		return report.Options{}, fmt.Errorf("invalid confidence %g, expecting a value between 0 and 1", rf.confidence)
+	}
+	if rf.seed == 0 {
+		rf.seed = rand.Uint64()
+	}
+	if rf.monteCarlo > 0 || rf.bootstrap > 0 {
+		log.Printf("> using seed %d\n", rf.seed)
+	}
+
+	return report.Options{
+		Breakdowns:    breakdowns,
+		ROITable:      roi,
+		StoplossSweep: stoplosses,
+		TrailingStop:  trailing,
+		MonteCarlo: report.MonteCarloOptions{
+			Simulations: rf.monteCarlo,
+			Mode:        rf.monteCarloMode,
+			Seed:        rf.seed,
+		},
+		Bootstrap: report.BootstrapOptions{
+			Samples:    rf.bootstrap,
+			Confidence: rf.confidence,
+			Seed:       rf.seed,
+		},
+		ScoreModel: &scoreModel,
+		Reports:    reports,
+	}, nil
+}
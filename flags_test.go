@@ -0,0 +1,51 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPrepareOutputDir(t *testing.T) {
+	testCases := []struct {
+		name   string
+		format string
+		dir    string
+		err    bool
+	}{
+		{name: "no directory", format: "json"},
+		{name: "table format", format: "csv", dir: "tables"},
+		{name: "json format", format: "json", dir: "tables", err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := &globalOptions{format: tc.format, color: "never"}
+			dir := ""
+			if tc.dir != "" {
+				dir = filepath.Join(t.TempDir(), tc.dir)
+			}
+
+			err := g.prepareOutputDir(dir)
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if dir == "" {
+				return
+			}
+			info, err := os.Stat(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !info.IsDir() {
+				t.Errorf("expected %s to be a directory", dir)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ go 1.23
 require (
 	github.com/jedib0t/go-pretty/v6 v6.6.5
 	gonum.org/v1/gonum v0.15.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
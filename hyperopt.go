@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/render"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+)
+
+// runHyperopt runs the hyperopt command, which re-scores every epoch of a hyperopt result file
+// and prints the top epochs along with the parameter sensitivity.
+func runHyperopt(args []string) error {
+	fs := newFlagSet("hyperopt", "<hyperopt result file (.fthypt)>")
+	g := addGlobalFlags(fs, tableFormats...)
+	rf := newReportFlags()
+	rf.addScoreFlags(fs)
+	top := fs.Int("top", 10, "number of top epochs to show")
+	buckets := fs.Int("buckets", 5, "number of buckets used to group numeric parameters with many values")
+	err := g.parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(g.inputs) != 1 {
+		return fmt.Errorf("expecting 1 input got %d", len(g.inputs))
+	}
+	if len(g.strategies) > 0 {
+		return fmt.Errorf("--strategy is not supported by hyperopt, epochs all belong to the same strategy")
+	}
+
+	scoreModel, err := rf.scoreModel()
+	if err != nil {
+		return err
+	}
+
+	epochs, err := backtest.LoadHyperoptEpochs(g.inputs[0], scoreModel.Score)
+	if err != nil {
+		return err
+	}
+	log.Printf("> loaded %d hyperopt epochs from %s\n", len(epochs), g.inputs[0])
+
+	report.SortEpochs(epochs)
+
+	renderOpts := render.Options{Format: g.format}
+	render.PrintTopEpochs(epochs, *top, renderOpts)
+	render.PrintParamSensitivity(report.HyperoptParamSensitivity(epochs, *buckets), renderOpts)
+
+	return nil
+}
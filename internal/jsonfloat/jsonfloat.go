@@ -0,0 +1,14 @@
+// Package jsonfloat encodes floats which JSON can not represent.
+package jsonfloat
+
+import "math"
+
+// Finite returns a pointer to v, or nil when v is NaN or infinite
+// since those values can not be encoded in JSON.
+func Finite(v float64) *float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil
+	}
+
+	return &v
+}
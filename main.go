@@ -1,52 +1,69 @@
 package main
 
 import (
-	"encoding/json"
-	"io"
+	"fmt"
 	"log"
 	"os"
 )
 
-func main() {
-	log.Println("> start")
-
-	if len(os.Args[1:]) < 1 {
-		log.Fatalf("expecting 1 argument got %d\n", len(os.Args[1:]))
-	}
-
-	filename := os.Args[1]
-	backtestResult, err := loadBacktestResultFromFilename(filename)
-	if err != nil {
-		log.Fatal(err)
-	}
-	log.Printf("> loaded backtest result\n")
+// command is a subcommand of the analyzer
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
 
-	backtestResult.Print()
+// commands lists the available subcommands, analyze is the default one
+var commands = []command{
+	{name: "analyze", summary: "print the reports of backtest results", run: runAnalyze},
+	{name: "compare", summary: "compare strategies side by side against a baseline", run: runCompare},
+	{name: "rank", summary: "score many backtest results and print a leaderboard", run: runRank},
+	{name: "hyperopt", summary: "re-score hyperopt epochs and show parameter sensitivity", run: runHyperopt},
+	{name: "export", summary: "export trades with derived columns as csv, tsv or json", run: runExport},
+	{name: "serve", summary: "serve reports as JSON over HTTP", run: runServe},
+	{name: "diff", summary: "match the trades of two backtest results and show what changed", run: runDiff},
 }
 
-func loadBacktestResultFromFilename(filename string) (*BacktestResult, error) {
-	f, err := os.OpenFile(filename, os.O_RDONLY, 0755)
-	if err != nil {
-		return nil, err
-	}
-	log.Printf("> opened %s\n", filename)
+func main() {
+	args := os.Args[1:]
 
-	data, err := io.ReadAll(f)
-	if err != nil {
-		return nil, err
+	if len(args) > 0 {
+		switch args[0] {
+		case "help", "-h", "-help", "--help":
+			if len(args) > 1 {
+				// help <command> is an alias of <command> --help
+				args = []string{args[1], "--help"}
+				break
+			}
+			usage()
+			return
+		}
 	}
-	log.Printf("> read %d bytes from %s\n", len(data), filename)
 
-	err = f.Close()
-	if err != nil {
-		log.Printf("> WARNING: failed to close file %s: %v\n", filename, err)
+	cmd := commands[0]
+	if len(args) > 0 {
+		for _, c := range commands {
+			if c.name == args[0] {
+				cmd = c
+				args = args[1:]
+				break
+			}
+		}
 	}
 
-	var backtestResult *BacktestResult
-	err = json.Unmarshal(data, &backtestResult)
+	err := cmd.run(args)
 	if err != nil {
-		return nil, err
+		log.Fatal(err)
 	}
+}
 
-	return backtestResult, nil
+// usage prints the list of commands
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s [command] [flags] [input]...\n\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Commands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintf(os.Stderr, "\nThe command defaults to %s when omitted.\n", commands[0].name)
+	fmt.Fprintf(os.Stderr, "Run '%s <command> --help' to list the flags of a command.\n", os.Args[0])
 }
@@ -0,0 +1,62 @@
+package backtest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"os"
+)
+
+// HyperoptEpoch represents a single epoch of a freqtrade hyperopt result file (.fthypt)
+type HyperoptEpoch struct {
+	Loss           float64                `json:"loss"`
+	CurrentEpoch   int                    `json:"current_epoch"`
+	IsBest         bool                   `json:"is_best"`
+	IsInitialPoint bool                   `json:"is_initial_point"`
+	ParamsDict     map[string]interface{} `json:"params_dict"`
+	ResultsMetrics Strategy               `json:"results_metrics"`
+
+	// Score is the epoch score, computed when loading the epoch
+	Score float64 `json:"-"`
+}
+
+// LoadHyperoptEpochs streams a hyperopt result file, one JSON epoch per line,
+// and scores every epoch using score. Trades are dropped once scored to keep memory low.
+func LoadHyperoptEpochs(filename string, score func(s Strategy) float64) ([]HyperoptEpoch, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var epochs []HyperoptEpoch
+	reader := bufio.NewReader(f)
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+
+		data = bytes.TrimSpace(data)
+		if len(data) > 0 {
+			var epoch HyperoptEpoch
+			uerr := json.Unmarshal(data, &epoch)
+			if uerr != nil {
+				log.Printf("> WARNING: skipping line %d of %s: %v\n", line, filename, uerr)
+			} else {
+				epoch.Score = score(epoch.ResultsMetrics)
+				epoch.ResultsMetrics.Trades = nil
+				epochs = append(epochs, epoch)
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+	}
+
+	return epochs, nil
+}
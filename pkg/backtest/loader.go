@@ -0,0 +1,282 @@
+package backtest
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// zipMagic is the signature found at the beginning of zip archives
+var zipMagic = []byte("PK\x03\x04")
+
+// LoadFile loads a backtest result from a freqtrade result file, either JSON or zip archive
+func LoadFile(filename string) (*BacktestResult, error) {
+	f, err := os.OpenFile(filename, os.O_RDONLY, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.Close()
+	if err != nil {
+		log.Printf("> WARNING: failed to close file %s: %v\n", filename, err)
+	}
+
+	var backtestResult *BacktestResult
+	if bytes.HasPrefix(data, zipMagic) {
+		backtestResult, err = loadBacktestResultFromZip(data, filename)
+	} else {
+		err = json.Unmarshal(data, &backtestResult)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if backtestResult == nil {
+		return nil, fmt.Errorf("no backtest result found in %s", filename)
+	}
+
+	backtestResult.Filename = filename
+
+	return backtestResult, nil
+}
+
+// Name returns the name of the BacktestResult, the file name without its extension
+func (br BacktestResult) Name() string {
+	name := filepath.Base(br.Filename)
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
+
+// loadBacktestResultFromZip loads a backtest result from a freqtrade zip archive.
+// The archive holds the result JSON along with the config and strategy files used
+// for the backtest, those are attached to the returned BacktestResult.
+func loadBacktestResultFromZip(data []byte, filename string) (*BacktestResult, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("> %s is a zip archive with %d files\n", filename, len(r.File))
+
+	// freqtrade names the result file after the archive,
+	// e.g. backtest-result-2025-01-01_00-00-00.zip contains backtest-result-2025-01-01_00-00-00.json
+	resultName := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename)) + ".json"
+
+	var backtestResult *BacktestResult
+	var config map[string]interface{}
+	strategySources := make(map[string]string)
+	var candidates []*zip.File
+
+	for _, zf := range r.File {
+		name := path.Base(zf.Name)
+		switch {
+		case strings.HasSuffix(name, "_config.json"):
+			content, err := readZipFile(zf)
+			if err != nil {
+				return nil, err
+			}
+			err = json.Unmarshal(content, &config)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode %s: %w", zf.Name, err)
+			}
+		case strings.HasSuffix(name, ".py"):
+			content, err := readZipFile(zf)
+			if err != nil {
+				return nil, err
+			}
+			strategySources[name] = string(content)
+		case strings.HasSuffix(name, ".json"):
+			// Prefer the file named after the archive
+			if name == resultName {
+				candidates = append([]*zip.File{zf}, candidates...)
+			} else {
+				candidates = append(candidates, zf)
+			}
+		}
+	}
+
+	// Result file is the first json file holding strategy results,
+	// strategy parameter files also live in the archive and are skipped.
+	for _, zf := range candidates {
+		content, err := readZipFile(zf)
+		if err != nil {
+			return nil, err
+		}
+
+		var result *BacktestResult
+		err = json.Unmarshal(content, &result)
+		if err != nil || result == nil || len(result.Strategy) == 0 {
+			continue
+		}
+
+		log.Printf("> found backtest result %s in %s\n", zf.Name, filename)
+		backtestResult = result
+		break
+	}
+
+	if backtestResult == nil {
+		return nil, fmt.Errorf("no backtest result found in %s", filename)
+	}
+
+	backtestResult.Config = config
+	backtestResult.StrategySources = strategySources
+
+	return backtestResult, nil
+}
+
+// readZipFile returns the content of a file from a zip archive
+func readZipFile(zf *zip.File) ([]byte, error) {
+	rc, err := zf.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
+
+// LastResultFilename is the file freqtrade writes in the backtest results directory
+// to point at the latest backtest result
+const LastResultFilename = ".last_result.json"
+
+// lastResult represents the content of .last_result.json
+type lastResult struct {
+	LatestBacktest string `json:"latest_backtest"`
+}
+
+// ResolveInputs returns the backtest result files to load from the given input.
+// Input can be a result file, a .last_result.json file or a backtest results directory.
+// When latest is greater than 0 and input is a directory, the latest most recent results
+// from the directory are returned instead of the one pointed by .last_result.json.
+func ResolveInputs(input string, latest int) ([]string, error) {
+	info, err := os.Stat(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		if filepath.Base(input) == LastResultFilename {
+			filename, err := readLastResult(input)
+			if err != nil {
+				return nil, err
+			}
+			return []string{filename}, nil
+		}
+		return []string{input}, nil
+	}
+
+	if latest > 0 {
+		filenames, err := ListResults(input)
+		if err != nil {
+			return nil, err
+		}
+		if len(filenames) == 0 {
+			return nil, fmt.Errorf("no backtest result found in %s", input)
+		}
+		if len(filenames) > latest {
+			filenames = filenames[:latest]
+		}
+		return filenames, nil
+	}
+
+	filename, err := readLastResult(filepath.Join(input, LastResultFilename))
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{filename}, nil
+}
+
+// readLastResult returns the backtest result file pointed by a .last_result.json file
+func readLastResult(filename string) (string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+
+	var lr lastResult
+	err = json.Unmarshal(data, &lr)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode %s: %w", filename, err)
+	}
+	if lr.LatestBacktest == "" {
+		return "", fmt.Errorf("no latest backtest found in %s", filename)
+	}
+	log.Printf("> latest backtest result is %s\n", lr.LatestBacktest)
+
+	return filepath.Join(filepath.Dir(filename), lr.LatestBacktest), nil
+}
+
+// ListResults returns the backtest result files from a directory,
+// most recent first. When a result exists both as json and zip, the zip is kept.
+func ListResults(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var filenames []string
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasPrefix(e.Name(), "backtest-result-") {
+			continue
+		}
+		filenames = append(filenames, filepath.Join(dir, e.Name()))
+	}
+
+	return FilterResults(filenames), nil
+}
+
+// FilterResults returns the backtest result files among filenames, most recent first.
+// Metadata, config and .last_result.json files are skipped,
+// when a result exists both as json and zip, the zip is kept.
+func FilterResults(filenames []string) []string {
+	results := make(map[string]string)
+	for _, filename := range filenames {
+		ext := filepath.Ext(filename)
+		base := strings.TrimSuffix(filepath.Base(filename), ext)
+		if ext != ".json" && ext != ".zip" {
+			continue
+		}
+		// skip metadata and config files, e.g. backtest-result-*.meta.json
+		if strings.Contains(base, ".") || strings.HasSuffix(base, "_config") {
+			continue
+		}
+
+		key := strings.TrimSuffix(filename, ext)
+		if _, ok := results[key]; ok && ext == ".json" {
+			continue
+		}
+		results[key] = filename
+	}
+
+	keys := make([]string, 0, len(results))
+	for key := range results {
+		keys = append(keys, key)
+	}
+
+	// result names embed their timestamp, sorting names sorts by date
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := filepath.Base(keys[i]), filepath.Base(keys[j])
+		if a == b {
+			return keys[i] > keys[j]
+		}
+		return a > b
+	})
+
+	filtered := make([]string, 0, len(keys))
+	for _, key := range keys {
+		filtered = append(filtered, results[key])
+	}
+
+	return filtered
+}
@@ -0,0 +1,380 @@
+package backtest
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// writeZip writes a zip archive holding files, by name, to filename
+func writeZip(t *testing.T, filename string, files map[string]string) {
+	t.Helper()
+
+	f, err := os.Create(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	for name, content := range files {
+		zf, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = zf.Write([]byte(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	result := `{"strategy": {"SampleStrategy": {"total_trades": 3}}}`
+	params := `{"strategy_name": "SampleStrategy", "params": {"roi": {"0": 0.1}}}`
+
+	testCases := []struct {
+		name     string
+		filename string
+		// content is written as is when files is nil, files are written as a zip archive otherwise
+		content    string
+		files      map[string]string
+		strategies []string
+		config     bool
+		sources    []string
+		err        bool
+	}{
+		{
+			name:       "json",
+			filename:   "backtest-result-2024-01-01_00-00-00.json",
+			content:    result,
+			strategies: []string{"SampleStrategy"},
+		},
+		{
+			name:     "zip",
+			filename: "backtest-result-2024-01-01_00-00-00.zip",
+			files: map[string]string{
+				"SampleStrategy.json":                             params,
+				"SampleStrategy.py":                               "class SampleStrategy: pass",
+				"backtest-result-2024-01-01_00-00-00.json":        result,
+				"backtest-result-2024-01-01_00-00-00_config.json": `{"stake_currency": "USDT"}`,
+			},
+			strategies: []string{"SampleStrategy"},
+			config:     true,
+			sources:    []string{"SampleStrategy.py"},
+		},
+		{
+			name:     "zip with a result named differently",
+			filename: "renamed.zip",
+			files: map[string]string{
+				"SampleStrategy.json":                      params,
+				"backtest-result-2024-01-01_00-00-00.json": result,
+			},
+			strategies: []string{"SampleStrategy"},
+		},
+		{
+			name:     "zip detected by its content",
+			filename: "backtest-result-2024-01-01_00-00-00.json",
+			files: map[string]string{
+				"backtest-result-2024-01-01_00-00-00.json": result,
+			},
+			strategies: []string{"SampleStrategy"},
+		},
+		{
+			name:     "zip without result",
+			filename: "backtest-result-2024-01-01_00-00-00.zip",
+			files: map[string]string{
+				"SampleStrategy.json": params,
+			},
+			err: true,
+		},
+		{
+			name:     "invalid json",
+			filename: "backtest-result-2024-01-01_00-00-00.json",
+			content:  `{"strategy": `,
+			err:      true,
+		},
+		{
+			name:     "missing file",
+			filename: "",
+			err:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), tc.filename)
+			switch {
+			case tc.files != nil:
+				writeZip(t, filename, tc.files)
+			case tc.filename != "":
+				err := os.WriteFile(filename, []byte(tc.content), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			backtestResult, err := LoadFile(filename)
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected an error, got %+v", backtestResult)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			var strategies []string
+			for name := range backtestResult.Strategy {
+				strategies = append(strategies, name)
+			}
+			if !reflect.DeepEqual(strategies, tc.strategies) {
+				t.Errorf("expected strategies %v, got %v", tc.strategies, strategies)
+			}
+			if config := backtestResult.Config != nil; config != tc.config {
+				t.Errorf("expected config %v, got %v", tc.config, backtestResult.Config)
+			}
+
+			var sources []string
+			for name := range backtestResult.StrategySources {
+				sources = append(sources, name)
+			}
+			sort.Strings(sources)
+			if !reflect.DeepEqual(sources, tc.sources) {
+				t.Errorf("expected strategy sources %v, got %v", tc.sources, sources)
+			}
+		})
+	}
+}
+
+// writeFiles creates files, by name, with the given content in dir
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestListResults(t *testing.T) {
+	testCases := []struct {
+		name     string
+		files    []string
+		expected []string
+	}{
+		{
+			name:     "newest first",
+			files:    []string{"backtest-result-2024-01-01_00-00-00.json", "backtest-result-2024-03-01_00-00-00.json", "backtest-result-2024-02-01_00-00-00.json"},
+			expected: []string{"backtest-result-2024-03-01_00-00-00.json", "backtest-result-2024-02-01_00-00-00.json", "backtest-result-2024-01-01_00-00-00.json"},
+		},
+		{
+			name:     "zip over json",
+			files:    []string{"backtest-result-2024-01-01_00-00-00.json", "backtest-result-2024-01-01_00-00-00.zip", "backtest-result-2024-02-01_00-00-00.json"},
+			expected: []string{"backtest-result-2024-02-01_00-00-00.json", "backtest-result-2024-01-01_00-00-00.zip"},
+		},
+		{
+			name: "metadata, config and other files are skipped",
+			files: []string{
+				".last_result.json",
+				"backtest-result-2024-01-01_00-00-00.json",
+				"backtest-result-2024-01-01_00-00-00.meta.json",
+				"backtest-result-2024-01-01_00-00-00_config.json",
+				"backtest-result-2024-01-01_00-00-00_SampleStrategy.py",
+				"hyperopt_results.json",
+			},
+			expected: []string{"backtest-result-2024-01-01_00-00-00.json"},
+		},
+		{
+			name: "empty directory",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			files := make(map[string]string)
+			for _, name := range tc.files {
+				files[name] = "{}"
+			}
+			writeFiles(t, dir, files)
+			err := os.Mkdir(filepath.Join(dir, "backtest-result-2025-01-01_00-00-00.json"), 0755)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			filenames, err := ListResults(dir)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			expected := []string{}
+			for _, name := range tc.expected {
+				expected = append(expected, filepath.Join(dir, name))
+			}
+			if !reflect.DeepEqual(filenames, expected) {
+				t.Errorf("expected %v, got %v", expected, filenames)
+			}
+		})
+	}
+}
+
+func TestResolveInputs(t *testing.T) {
+	testCases := []struct {
+		name   string
+		files  map[string]string
+		input  string
+		latest int
+		// expected are file names relative to the test directory
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "result file",
+			files:    map[string]string{"backtest-result-2024-01-01_00-00-00.json": "{}"},
+			input:    "backtest-result-2024-01-01_00-00-00.json",
+			expected: []string{"backtest-result-2024-01-01_00-00-00.json"},
+		},
+		{
+			name: "last result file",
+			files: map[string]string{
+				".last_result.json":                        `{"latest_backtest": "backtest-result-2024-01-01_00-00-00.zip"}`,
+				"backtest-result-2024-01-01_00-00-00.zip":  "",
+				"backtest-result-2024-02-01_00-00-00.json": "{}",
+			},
+			input:    ".last_result.json",
+			expected: []string{"backtest-result-2024-01-01_00-00-00.zip"},
+		},
+		{
+			name: "directory",
+			files: map[string]string{
+				".last_result.json":                        `{"latest_backtest": "backtest-result-2024-01-01_00-00-00.zip"}`,
+				"backtest-result-2024-01-01_00-00-00.zip":  "",
+				"backtest-result-2024-02-01_00-00-00.json": "{}",
+			},
+			input:    ".",
+			expected: []string{"backtest-result-2024-01-01_00-00-00.zip"},
+		},
+		{
+			name: "latest results of a directory",
+			files: map[string]string{
+				".last_result.json":                        `{"latest_backtest": "backtest-result-2024-01-01_00-00-00.zip"}`,
+				"backtest-result-2024-01-01_00-00-00.zip":  "",
+				"backtest-result-2024-02-01_00-00-00.json": "{}",
+				"backtest-result-2024-03-01_00-00-00.json": "{}",
+			},
+			input:    ".",
+			latest:   2,
+			expected: []string{"backtest-result-2024-03-01_00-00-00.json", "backtest-result-2024-02-01_00-00-00.json"},
+		},
+		{
+			name:   "latest results of an empty directory",
+			input:  ".",
+			latest: 1,
+			err:    true,
+		},
+		{
+			name:  "directory without last result",
+			files: map[string]string{"backtest-result-2024-01-01_00-00-00.json": "{}"},
+			input: ".",
+			err:   true,
+		},
+		{
+			name:  "last result without latest backtest",
+			files: map[string]string{".last_result.json": `{}`},
+			input: ".last_result.json",
+			err:   true,
+		},
+		{
+			name:  "invalid last result",
+			files: map[string]string{".last_result.json": `{"latest_backtest": 1}`},
+			input: ".last_result.json",
+			err:   true,
+		},
+		{
+			name:  "missing input",
+			input: "backtest-result-2024-01-01_00-00-00.json",
+			err:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.files)
+
+			filenames, err := ResolveInputs(filepath.Join(dir, tc.input), tc.latest)
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected an error, got %v", filenames)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			var expected []string
+			for _, name := range tc.expected {
+				expected = append(expected, filepath.Join(dir, name))
+			}
+			if !reflect.DeepEqual(filenames, expected) {
+				t.Errorf("expected %v, got %v", expected, filenames)
+			}
+		})
+	}
+}
+
+func TestFilterResults(t *testing.T) {
+	testCases := []struct {
+		name      string
+		filenames []string
+		expected  []string
+	}{
+		{
+			name:      "newest first across directories",
+			filenames: []string{"a/backtest-result-2024-01-01_00-00-00.json", "b/backtest-result-2024-03-01_00-00-00.json", "b/backtest-result-2024-02-01_00-00-00.zip"},
+			expected:  []string{"b/backtest-result-2024-03-01_00-00-00.json", "b/backtest-result-2024-02-01_00-00-00.zip", "a/backtest-result-2024-01-01_00-00-00.json"},
+		},
+		{
+			name:      "zip over json in the same directory only",
+			filenames: []string{"a/backtest-result-2024-01-01_00-00-00.zip", "a/backtest-result-2024-01-01_00-00-00.json", "b/backtest-result-2024-01-01_00-00-00.json"},
+			expected:  []string{"b/backtest-result-2024-01-01_00-00-00.json", "a/backtest-result-2024-01-01_00-00-00.zip"},
+		},
+		{
+			name: "metadata, config and last result files are skipped",
+			filenames: []string{
+				"a/.last_result.json",
+				"a/backtest-result-2024-01-01_00-00-00.meta.json",
+				"a/backtest-result-2024-01-01_00-00-00_config.json",
+				"a/backtest-result-2024-01-01_00-00-00.feather",
+				"a/result.json",
+			},
+			expected: []string{"a/result.json"},
+		},
+		{
+			name:     "no file",
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filenames := FilterResults(tc.filenames)
+			if !reflect.DeepEqual(filenames, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, filenames)
+			}
+		})
+	}
+}
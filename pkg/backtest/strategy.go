@@ -0,0 +1,199 @@
+package backtest
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// strategyMetrics maps metric names, as used in scoring models, to their value in a strategy
+var strategyMetrics = map[string]func(s Strategy) float64{
+	"total_trades":          func(s Strategy) float64 { return float64(s.TotalTrades) },
+	"starting_balance":      func(s Strategy) float64 { return s.StartingBalance },
+	"final_balance":         func(s Strategy) float64 { return s.FinalBalance },
+	"profit_total_abs":      func(s Strategy) float64 { return s.ProfitTotalAbs },
+	"profit_total":          func(s Strategy) float64 { return s.ProfitTotal },
+	"profit_mean":           func(s Strategy) float64 { return s.ProfitMean },
+	"profit_factor":         func(s Strategy) float64 { return s.ProfitFactor },
+	"cagr":                  func(s Strategy) float64 { return s.CAGR },
+	"sortino":               func(s Strategy) float64 { return s.Sortino },
+	"sharpe":                func(s Strategy) float64 { return s.Sharpe },
+	"calmar":                func(s Strategy) float64 { return s.Calmar },
+	"expectancy":            func(s Strategy) float64 { return s.Expectancy },
+	"trades_per_day":        func(s Strategy) float64 { return s.TradesPerDay },
+	"avg_stake_amount":      func(s Strategy) float64 { return s.AvgStakeAmount },
+	"total_volume":          func(s Strategy) float64 { return s.TotalVolume },
+	"profit_total_long":     func(s Strategy) float64 { return s.ProfitTotalLong },
+	"profit_total_short":    func(s Strategy) float64 { return s.ProfitTotalShort },
+	"wins":                  func(s Strategy) float64 { return float64(s.Wins) },
+	"draws":                 func(s Strategy) float64 { return float64(s.Draws) },
+	"losses":                func(s Strategy) float64 { return float64(s.Losses) },
+	"win_rate":              func(s Strategy) float64 { return s.WinRate() },
+	"holding_avg_s":         func(s Strategy) float64 { return s.HoldingAvgDuration },
+	"winner_holding_avg_s":  func(s Strategy) float64 { return s.WinnderAvgDuration },
+	"loser_holding_avg_s":   func(s Strategy) float64 { return s.LoserAvgDuration },
+	"max_relative_drawdown": func(s Strategy) float64 { return s.DrawdownRelative },
+	"max_drawdown_account":  func(s Strategy) float64 { return s.DrawdownAbsAccount },
+	"max_drawdown_abs":      func(s Strategy) float64 { return s.DrawdownAbs },
+	"market_change":         func(s Strategy) float64 { return s.MarketChange },
+	"backtest_days":         func(s Strategy) float64 { return float64(s.BacktestDays) },
+}
+
+// Metric returns the value of the named metric of the Strategy, metrics are named after freqtrade fields
+func (s Strategy) Metric(name string) (float64, error) {
+	metric, ok := strategyMetrics[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown metric %q, expecting one of %s", name, strings.Join(MetricNames(), ", "))
+	}
+
+	return metric(s), nil
+}
+
+// MetricNames returns the sorted list of known metric names
+func MetricNames() []string {
+	names := make([]string, 0, len(strategyMetrics))
+	for name := range strategyMetrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// IsMetric returns whether name is a known metric name
+func IsMetric(name string) bool {
+	_, ok := strategyMetrics[name]
+	return ok
+}
+
+// WinRate returns the ratio of winning trades of the Strategy
+func (s Strategy) WinRate() float64 {
+	if s.TotalTrades == 0 {
+		return 0
+	}
+
+	return float64(s.Wins) / float64(s.TotalTrades)
+}
+
+// ClosedTrades returns the closed trades of the Strategy, ordered by close date
+func (s Strategy) ClosedTrades() []Trade {
+	var trades []Trade
+	for _, t := range s.Trades {
+		if !t.IsOpen {
+			trades = append(trades, t)
+		}
+	}
+
+	sort.SliceStable(trades, func(i, j int) bool {
+		return trades[i].CloseDate.Before(trades[j].CloseDate.Time)
+	})
+
+	return trades
+}
+
+// String returns a string representation of the MinimalROISorted
+// in the format "name:value name:value ..."
+func (mr MinimalROISorted) String() string {
+	var output []string
+	for _, v := range mr {
+		output = append(output, fmt.Sprintf("%s:%.3f", v.Name, v.Value))
+	}
+
+	return strings.Join(output, "  ")
+}
+
+// SortMinimalROI sorts the MinimalROI map by value into MinimalROISorted
+func (s *Strategy) SortMinimalROI() {
+	s.MinimalROISorted = MinimalROIFromMap(s.MinimalROI)
+}
+
+// MinimalROIFromMap returns a MinimalROISorted from a minimal_roi map, sorted by value
+func MinimalROIFromMap(minimalROI map[string]float64) MinimalROISorted {
+	keys := make([]string, 0, len(minimalROI))
+
+	// get keys
+	for key := range minimalROI {
+		keys = append(keys, key)
+	}
+
+	// sort keys using values
+	sort.SliceStable(keys, func(i, j int) bool {
+		return minimalROI[keys[i]] > minimalROI[keys[j]]
+	})
+
+	// rebuild slice
+	var sortedMinimalROI MinimalROISorted
+	for _, k := range keys {
+		sortedMinimalROI = append(sortedMinimalROI, MinimalROI{
+			Name:  k,
+			Value: minimalROI[k],
+		})
+	}
+
+	return sortedMinimalROI
+}
+
+// StrategyNames returns the names of the strategies of the BacktestResult, sorted
+func (br BacktestResult) StrategyNames() []string {
+	names := make([]string, 0, len(br.Strategy))
+	for name := range br.Strategy {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// MatchStrategy returns whether the strategy name matches any of the patterns,
+// patterns use the path.Match syntax, e.g. "Sample*".
+func MatchStrategy(patterns []string, name string) (bool, error) {
+	for _, pattern := range patterns {
+		matched, err := path.Match(pattern, name)
+		if err != nil {
+			return false, fmt.Errorf("invalid strategy pattern %q: %w", pattern, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// FilterStrategies keeps the strategies of the BacktestResult matching any of the patterns,
+// every strategy is kept when patterns is empty.
+func (br *BacktestResult) FilterStrategies(patterns []string) error {
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	for name := range br.Strategy {
+		matched, err := MatchStrategy(patterns, name)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			delete(br.Strategy, name)
+		}
+	}
+
+	return nil
+}
+
+// In converts every date of the BacktestResult to the location loc
+func (br *BacktestResult) In(loc *time.Location) {
+	for name, s := range br.Strategy {
+		s.BacktestStart.Time = s.BacktestStart.In(loc)
+		s.BacktestEnd.Time = s.BacktestEnd.In(loc)
+		s.DrawdownStart.Time = s.DrawdownStart.In(loc)
+		s.DrawdownEnd.Time = s.DrawdownEnd.In(loc)
+		for i := range s.Trades {
+			t := &s.Trades[i]
+			t.OpenDate.Time = t.OpenDate.In(loc)
+			t.CloseDate.Time = t.CloseDate.In(loc)
+		}
+		br.Strategy[name] = s
+	}
+}
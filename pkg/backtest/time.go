@@ -0,0 +1,76 @@
+package backtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CustomTime decodes the dates found in freqtrade results
+type CustomTime struct {
+	time.Time
+}
+
+// DateTimeFormat is the date format used by freqtrade
+const DateTimeFormat = "2006-01-02 15:04:05"
+
+// dateTimeFormats are the date formats found in freqtrade results,
+// e.g. backtest_start uses DateTimeFormat while trades open_date carries a timezone.
+var dateTimeFormats = []string{
+	DateTimeFormat,
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05",
+	time.RFC3339Nano,
+	time.DateOnly,
+}
+
+// UnmarshalJSON decodes dates as strings in any of dateTimeFormats or as unix timestamps
+func (t *CustomTime) UnmarshalJSON(b []byte) (err error) {
+	value := strings.Trim(string(b), `"`)
+	if value == "" || value == "null" {
+		return nil
+	}
+
+	// numeric values are unix timestamps, in milliseconds as freqtrade writes them
+	if ts, err := strconv.ParseFloat(value, 64); err == nil {
+		if ts > 1e11 {
+			t.Time = time.UnixMilli(int64(ts)).UTC()
+		} else {
+			t.Time = time.Unix(int64(ts), 0).UTC()
+		}
+		return nil
+	}
+
+	for _, format := range dateTimeFormats {
+		var date time.Time
+		date, err = time.Parse(format, value)
+		if err == nil {
+			t.Time = date
+			return nil
+		}
+	}
+
+	return err
+}
+
+// UnmarshalJSON decodes a [date, profit] pair
+func (dp *DailyProfit) UnmarshalJSON(b []byte) error {
+	var pair []json.RawMessage
+	err := json.Unmarshal(b, &pair)
+	if err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return fmt.Errorf("invalid daily profit %s, expecting a [date, profit] pair", b)
+	}
+
+	err = dp.Date.UnmarshalJSON(pair[0])
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(pair[1], &dp.ProfitAbs)
+}
@@ -0,0 +1,81 @@
+package backtest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestCustomTimeUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected time.Time
+		err      bool
+	}{
+		{name: "freqtrade date time", value: `"2024-01-02 03:04:05"`, expected: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{name: "with timezone", value: `"2024-01-02 03:04:05+00:00"`, expected: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{name: "with fraction and timezone", value: `"2024-01-02 03:04:05.123456+02:00"`, expected: time.Date(2024, 1, 2, 1, 4, 5, 123456000, time.UTC)},
+		{name: "ISO 8601 without timezone", value: `"2024-01-02T03:04:05"`, expected: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{name: "RFC 3339", value: `"2024-01-02T03:04:05.5Z"`, expected: time.Date(2024, 1, 2, 3, 4, 5, 500000000, time.UTC)},
+		{name: "date only", value: `"2024-01-02"`, expected: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "unix timestamp in milliseconds", value: `1704164645000`, expected: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{name: "unix timestamp in seconds", value: `1704164645`, expected: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{name: "null", value: `null`},
+		{name: "empty", value: `""`},
+		{name: "invalid", value: `"yesterday"`, err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var ct CustomTime
+			err := json.Unmarshal([]byte(tc.value), &ct)
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected an error, got %v", ct.Time)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !ct.Equal(tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, ct.Time)
+			}
+		})
+	}
+}
+
+func TestDailyProfitUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected DailyProfit
+		err      bool
+	}{
+		{name: "date and profit", value: `["2024-01-02", -3.5]`, expected: DailyProfit{Date: CustomTime{time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}, ProfitAbs: -3.5}},
+		{name: "missing profit", value: `["2024-01-02"]`, err: true},
+		{name: "invalid date", value: `["yesterday", 1]`, err: true},
+		{name: "invalid profit", value: `["2024-01-02", "1"]`, err: true},
+		{name: "object", value: `{"date": "2024-01-02"}`, err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var dp DailyProfit
+			err := json.Unmarshal([]byte(tc.value), &dp)
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected an error, got %+v", dp)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !dp.Date.Equal(tc.expected.Date.Time) || dp.ProfitAbs != tc.expected.ProfitAbs {
+				t.Errorf("expected %+v, got %+v", tc.expected, dp)
+			}
+		})
+	}
+}
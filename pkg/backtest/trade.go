@@ -0,0 +1,70 @@
+package backtest
+
+import "math"
+
+// GetEnterTag returns the enter tag of a trade,
+// trades without tag are reported as OTHER like freqtrade does.
+func (t Trade) GetEnterTag() string {
+	if t.EnterTag == "" {
+		return "OTHER"
+	}
+
+	return t.EnterTag
+}
+
+// EffectiveLeverage returns the leverage of a trade, spot trades have a leverage of 1
+func (t Trade) EffectiveLeverage() float64 {
+	if t.Leverage <= 0 {
+		return 1
+	}
+
+	return t.Leverage
+}
+
+// FeeRatio returns the entry and exit fees of a trade as a ratio of its stake
+func (t Trade) FeeRatio() float64 {
+	return (t.FeeOpen + t.FeeClose) * t.EffectiveLeverage()
+}
+
+// MFE returns the maximum favorable excursion of a trade, as a ratio of its open rate
+// including leverage. Highest price is favorable for long trades and lowest price for short trades.
+func (t Trade) MFE() float64 {
+	if t.OpenRate == 0 {
+		return 0
+	}
+
+	if t.IsShort {
+		return (1 - t.MinRate/t.OpenRate) * t.EffectiveLeverage()
+	}
+
+	return (t.MaxRate/t.OpenRate - 1) * t.EffectiveLeverage()
+}
+
+// MAE returns the maximum adverse excursion of a trade, as a ratio of its open rate
+// including leverage. Lowest price is adverse for long trades and highest price for short trades.
+func (t Trade) MAE() float64 {
+	if t.OpenRate == 0 {
+		return 0
+	}
+
+	if t.IsShort {
+		return (1 - t.MaxRate/t.OpenRate) * t.EffectiveLeverage()
+	}
+
+	return (t.MinRate/t.OpenRate - 1) * t.EffectiveLeverage()
+}
+
+// LeftOnTable returns the profit left on the table by a trade, its maximum favorable excursion
+// minus its realized profit before fees, as a ratio.
+func (t Trade) LeftOnTable() float64 {
+	return math.Max(0, t.MFE()-(t.ProfitRatio+t.FeeRatio()))
+}
+
+// InitialStoploss returns the initial stoploss ratio of a trade, or fallback when the result does not record it
+func (t Trade) InitialStoploss(fallback float64) float64 {
+	if t.InitialStopLossRatio == 0 {
+		return fallback
+	}
+
+	return t.InitialStopLossRatio
+}
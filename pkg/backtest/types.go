@@ -0,0 +1,175 @@
+// Package backtest decodes freqtrade backtest and hyperopt results.
+package backtest
+
+// BacktestResult is the main structure that holds the backtest results
+type BacktestResult struct {
+	Strategy map[string]Strategy `json:"strategy"`
+
+	// StrategyComparison is the summary of every strategy as reported by freqtrade
+	StrategyComparison []ResultsRow `json:"strategy_comparison"`
+
+	// Filename is the file the result was loaded from
+	Filename string `json:"-"`
+
+	// Files bundled with the result in freqtrade zip archives
+	Config          map[string]interface{} `json:"-"`
+	StrategySources map[string]string      `json:"-"`
+}
+
+// Strategy represents the backtest results for a single strategy
+type Strategy struct {
+	// General purpose information
+	BacktestStart CustomTime `json:"backtest_start"`
+	BacktestEnd   CustomTime `json:"backtest_end"`
+	BacktestDays  int        `json:"backtest_days"`
+
+	// Finance informations
+	TotalTrades         int     `json:"total_trades"`
+	StartingBalance     float64 `json:"starting_balance"`
+	FinalBalance        float64 `json:"final_balance"`
+	ProfitTotalAbs      float64 `json:"profit_total_abs"`
+	ProfitTotal         float64 `json:"profit_total"`
+	ProfitMean          float64 `json:"profit_mean"`
+	ProfitFactor        float64 `json:"profit_factor"`
+	CAGR                float64 `json:"cagr"`
+	Sortino             float64 `json:"sortino"`
+	Sharpe              float64 `json:"sharpe"`
+	Calmar              float64 `json:"calmar"`
+	Expectancy          float64 `json:"expectancy"`
+	TradesPerDay        float64 `json:"trades_per_day"`
+	AvgStakeAmount      float64 `json:"avg_stake_amount"`
+	TotalVolume         float64 `json:"total_volume"`
+	TradeCountLong      int     `json:"trade_count_long"`
+	TradeCountShort     int     `json:"trade_count_short"`
+	ProfitTotalLong     float64 `json:"profit_total_long"`
+	ProfitTotalShort    float64 `json:"profit_total_short"`
+	ProfitTotalLongAbs  float64 `json:"profit_total_long_abs"`
+	ProfitTotalShortAbs float64 `json:"profit_total_short_abs"`
+
+	// Trading information
+	Wins   int `json:"wins"`
+	Draws  int `json:"draws"`
+	Losses int `json:"losses"`
+
+	HoldingAvgDuration float64 `json:"holding_avg_s"`
+	WinnderAvgDuration float64 `json:"winner_holding_avg_s"`
+	LoserAvgDuration   float64 `json:"loser_holding_avg_s"`
+
+	MinBalance         float64    `json:"csum_min"`
+	MaxBalance         float64    `json:"csum_max"`
+	DrawdownRelative   float64    `json:"max_relative_drawdown"`
+	DrawdownAbsAccount float64    `json:"max_drawdown_account"`
+	DrawdownAbs        float64    `json:"max_drawdown_abs"`
+	DrawdownHigh       float64    `json:"max_drawdown_high"`
+	DrawdownLow        float64    `json:"max_drawdown_low"`
+	DrawdownStart      CustomTime `json:"drawdown_start"`
+	DrawdownEnd        CustomTime `json:"drawdown_end"`
+	MarketChange       float64    `json:"market_change"`
+
+	Trades []Trade `json:"trades"`
+
+	// Config
+	MaxOpenTrades int                `json:"max_open_trades"`
+	MinimalROI    map[string]float64 `json:"minimal_roi"`
+	StakeCurrency string             `json:"stake_currency"`
+	Stoploss      float64            `json:"stoploss"`
+
+	// Results tables as reported by freqtrade, each ending with a TOTAL row
+	ResultsPerPair     []ResultsRow `json:"results_per_pair"`
+	ResultsPerEnterTag []ResultsRow `json:"results_per_enter_tag"`
+	ExitReasonSummary  []ResultsRow `json:"exit_reason_summary"`
+	LeftOpenTrades     []ResultsRow `json:"left_open_trades"`
+
+	// PeriodicBreakdown maps periods, e.g. "day", "week", "month", to freqtrade's profit per period
+	PeriodicBreakdown map[string][]PeriodResult `json:"periodic_breakdown"`
+	DailyProfit       []DailyProfit             `json:"daily_profit"`
+
+	MinimalROISorted MinimalROISorted
+}
+
+// Trade represents a single trade
+type Trade struct {
+	Pair           string     `json:"pair"`
+	EnterTag       string     `json:"enter_tag"`
+	ExitReason     string     `json:"exit_reason"`
+	IsOpen         bool       `json:"is_open"`
+	IsShort        bool       `json:"is_short"`
+	Leverage       float64    `json:"leverage"`
+	OpenDate       CustomTime `json:"open_date"`
+	CloseDate      CustomTime `json:"close_date"`
+	OpenTimestamp  int64      `json:"open_timestamp"`
+	CloseTimestamp int64      `json:"close_timestamp"`
+	TradeDuration  int        `json:"trade_duration"`
+
+	// Finance informations
+	StakeAmount    float64 `json:"stake_amount"`
+	MaxStakeAmount float64 `json:"max_stake_amount"`
+	Amount         float64 `json:"amount"`
+	OpenRate       float64 `json:"open_rate"`
+	CloseRate      float64 `json:"close_rate"`
+	MinRate        float64 `json:"min_rate"`
+	MaxRate        float64 `json:"max_rate"`
+	FeeOpen        float64 `json:"fee_open"`
+	FeeClose       float64 `json:"fee_close"`
+	FundingFees    float64 `json:"funding_fees"`
+	ProfitAbs      float64 `json:"profit_abs"`
+	ProfitRatio    float64 `json:"profit_ratio"`
+
+	// Stoploss informations
+	StopLossAbs          float64 `json:"stop_loss_abs"`
+	StopLossRatio        float64 `json:"stop_loss_ratio"`
+	InitialStopLossAbs   float64 `json:"initial_stop_loss_abs"`
+	InitialStopLossRatio float64 `json:"initial_stop_loss_ratio"`
+
+	Orders []Order `json:"orders"`
+}
+
+// ResultsRow represents a row of freqtrade results tables, keyed by pair, enter tag,
+// exit reason or strategy depending on the table
+type ResultsRow struct {
+	Key            string  `json:"key"`
+	Trades         int     `json:"trades"`
+	ProfitMean     float64 `json:"profit_mean"`
+	ProfitTotalAbs float64 `json:"profit_total_abs"`
+	ProfitTotal    float64 `json:"profit_total"`
+	Wins           int     `json:"wins"`
+	Draws          int     `json:"draws"`
+	Losses         int     `json:"losses"`
+}
+
+// PeriodResult represents the profit of a period of freqtrade periodic breakdown,
+// Date is the label freqtrade gives to the period, e.g. the last day of a month.
+type PeriodResult struct {
+	Date      CustomTime `json:"date_ts"`
+	ProfitAbs float64    `json:"profit_abs"`
+	Trades    int        `json:"trades"`
+	Wins      int        `json:"wins"`
+	Draws     int        `json:"draws"`
+	Losses    int        `json:"loses"`
+}
+
+// DailyProfit represents the profit of a day, freqtrade writes it as a [date, profit] pair
+type DailyProfit struct {
+	Date      CustomTime
+	ProfitAbs float64
+}
+
+// Order represents a single order of a trade
+type Order struct {
+	Side                 string  `json:"ft_order_side"`
+	Tag                  string  `json:"ft_order_tag"`
+	IsEntry              bool    `json:"ft_is_entry"`
+	Amount               float64 `json:"amount"`
+	SafePrice            float64 `json:"safe_price"`
+	Cost                 float64 `json:"cost"`
+	OrderFilledTimestamp int64   `json:"order_filled_timestamp"`
+}
+
+// MinimalROISorted is a slice of MinimalROI
+type MinimalROISorted []MinimalROI
+
+// MinimalROI represents minimal_roi settings
+type MinimalROI struct {
+	Name  string
+	Value float64
+}
@@ -0,0 +1,121 @@
+package render
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// PrintComparison renders a comparison table of the runs, with one column per run
+// and delta columns against the baseline run. The best value of each metric is highlighted.
+func PrintComparison(c report.Comparison, opts Options) {
+	r := newTableRenderer(opts, "comparison")
+	best := text.Colors{text.FgGreen, text.Bold}
+	base := c.Baseline
+
+	tCompare := table.NewWriter()
+	tCompare.AppendHeader(comparisonHeader(c, "Δ "))
+	tCompare.SetColumnConfigs(comparisonColumnConfigs(c))
+
+	for _, m := range c.Metrics {
+		bestValue := m.Best()
+
+		// highlight the best values, unless all runs are even
+		highlight := false
+		for _, v := range m.Values {
+			if v != bestValue {
+				highlight = true
+			}
+		}
+
+		row := table.Row{m.Name}
+		for _, v := range m.Values {
+			value := fmt.Sprintf(m.Format, v)
+			if highlight && v == bestValue {
+				value = best.Sprint(value)
+			}
+			row = append(row, value)
+		}
+		for i, v := range m.Values {
+			if i != base {
+				row = append(row, fmt.Sprintf("%+"+m.Format[1:], v-m.Values[base]))
+			}
+		}
+		tCompare.AppendRow(row)
+	}
+
+	r.Render(tCompare, "table")
+
+	if c.Bootstrap != nil {
+		printComparisonBootstrap(r, c)
+	}
+}
+
+// printComparisonBootstrap renders the bootstrap confidence interval of each run and
+// the probability of each run beating the baseline.
+func printComparisonBootstrap(r TableRenderer, c report.Comparison) {
+	bs := c.Bootstrap
+
+	tBootstrap := table.NewWriter()
+	tBootstrap.SetTitle("Bootstrap: %d samples, %g%% confidence intervals (seed %d)", bs.Samples, bs.Confidence*100, bs.Seed)
+	tBootstrap.AppendHeader(comparisonHeader(c, "P(> baseline) "))
+	tBootstrap.SetColumnConfigs(comparisonColumnConfigs(c))
+
+	for _, m := range bs.Metrics {
+		row := table.Row{m.Name}
+		for i := range c.Labels {
+			if math.IsNaN(m.Low[i]) {
+				row = append(row, "-")
+				continue
+			}
+			row = append(row, fmt.Sprintf("%.3f .. %.3f", m.Low[i], m.High[i]))
+		}
+		for i, p := range m.BeatBaseline {
+			if i == c.Baseline {
+				continue
+			}
+			if math.IsNaN(p) {
+				row = append(row, "-")
+				continue
+			}
+			row = append(row, fmt.Sprintf("%.1f%%", p*100))
+		}
+		tBootstrap.AppendRow(row)
+	}
+	tBootstrap.SetCaption("probabilities close to 50%% mean the difference with the baseline is likely noise")
+
+	r.Render(tBootstrap, "bootstrap")
+}
+
+// comparisonHeader returns the header of comparison tables, with one column per run
+// followed by one column per run other than the baseline, prefixed with prefix.
+func comparisonHeader(c report.Comparison, prefix string) table.Row {
+	header := table.Row{"Metric"}
+	for i, label := range c.Labels {
+		if i == c.Baseline {
+			header = append(header, label+" (baseline)")
+			continue
+		}
+		header = append(header, label)
+	}
+	for i, label := range c.Labels {
+		if i != c.Baseline {
+			header = append(header, prefix+label)
+		}
+	}
+
+	return header
+}
+
+// comparisonColumnConfigs returns the column configs of comparison tables, values are right aligned
+func comparisonColumnConfigs(c report.Comparison) []table.ColumnConfig {
+	columnConfigs := []table.ColumnConfig{}
+	for i := 2; i <= 2*len(c.Labels); i++ {
+		columnConfigs = append(columnConfigs, table.ColumnConfig{Number: i, Align: text.AlignRight})
+	}
+
+	return columnConfigs
+}
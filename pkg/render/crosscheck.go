@@ -0,0 +1,131 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// crossCheckHeaders are the column headers of cross checked fields
+var crossCheckHeaders = map[string]string{
+	"trades":           "Trades",
+	"profit_mean":      "Avg Profit %",
+	"profit_total_abs": "Tot Profit",
+	"wins":             "Wins",
+	"draws":            "Draws",
+	"losses":           "Losses",
+}
+
+// crossCheckKeyHeaders are the key column headers of freqtrade results tables
+var crossCheckKeyHeaders = map[string]string{
+	"results_per_pair":      "Pair",
+	"results_per_enter_tag": "Enter Tag",
+	"exit_reason_summary":   "Exit Reason",
+	"left_open_trades":      "Pair",
+}
+
+// printCrossCheck renders the freqtrade results tables, flagging the rows differing from the recomputed trades.
+// Only the differing rows of periodic tables are shown.
+func printCrossCheck(r TableRenderer, c ReportContext) {
+	cc := c.Report.CrossCheck
+	if cc == nil {
+		return
+	}
+	tf := newTransformers(c.Strategy)
+	mismatch := text.Colors{text.FgRed}
+
+	tOverview := table.NewWriter()
+	tOverview.SetTitle("freqtrade results cross check")
+	tOverview.AppendHeader(table.Row{"Table", "Rows", "Mismatches"})
+	tOverview.SetColumnConfigs([]table.ColumnConfig{
+		{Name: "Rows", Align: text.AlignRight},
+		{Name: "Mismatches", Align: text.AlignRight},
+	})
+	for _, section := range cc.Sections {
+		mismatches := fmt.Sprint(section.Mismatches)
+		if section.Mismatches > 0 {
+			mismatches = mismatch.Sprint(mismatches)
+		}
+		tOverview.AppendRow(table.Row{section.Name, len(section.Rows), mismatches})
+	}
+	r.Render(tOverview, "freqtrade")
+
+	for _, section := range cc.Sections {
+		if section.Periodic && section.Mismatches == 0 {
+			continue
+		}
+
+		keyHeader, ok := crossCheckKeyHeaders[section.Name]
+		if !ok {
+			keyHeader = "Date"
+		}
+		header := table.Row{keyHeader}
+		configs := []table.ColumnConfig{}
+		for _, f := range section.Fields {
+			name := crossCheckHeaders[f]
+			header = append(header, name)
+			config := table.ColumnConfig{Name: name, Align: text.AlignRight}
+			switch f {
+			case "profit_mean":
+				config.Transformer = tf.percentage
+			case "profit_total_abs":
+				config.Transformer = tf.price
+			}
+			configs = append(configs, config)
+		}
+		header = append(header, "Check")
+
+		tSection := table.NewWriter()
+		tSection.SetTitle("freqtrade %s", strings.ReplaceAll(section.Name, "_", " "))
+		tSection.AppendHeader(header)
+		tSection.SetColumnConfigs(configs)
+		for _, row := range section.Rows {
+			if section.Periodic && !row.Mismatch() {
+				continue
+			}
+
+			// rows missing from freqtrade show the recomputed values
+			values := row.Reported
+			if values == nil {
+				values = row.Recomputed
+			}
+			tableRow := table.Row{row.Key}
+			for _, f := range section.Fields {
+				tableRow = append(tableRow, crossCheckValue(*values, f))
+			}
+			check := "ok"
+			if row.Mismatch() {
+				check = mismatch.Sprint(row.Describe())
+			}
+			tableRow = append(tableRow, check)
+			tSection.AppendRow(tableRow)
+		}
+		if section.Periodic {
+			tSection.SetCaption("%d of %d rows match", len(section.Rows)-section.Mismatches, len(section.Rows))
+		}
+		r.Render(tSection, "freqtrade-"+strings.ReplaceAll(section.Name, " ", "-"))
+	}
+}
+
+// crossCheckValue returns the named field of v, as an int for counts
+func crossCheckValue(v report.CrossCheckValues, field string) interface{} {
+	switch field {
+	case "trades":
+		return v.Trades
+	case "profit_mean":
+		return v.ProfitMean
+	case "profit_total_abs":
+		return v.ProfitTotalAbs
+	case "wins":
+		return v.Wins
+	case "draws":
+		return v.Draws
+	case "losses":
+		return v.Losses
+	}
+
+	return nil
+}
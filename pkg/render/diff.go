@@ -0,0 +1,117 @@
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// PrintTradeDiff renders the differences between the trades of runs labelled a and b,
+// at most limit trades are listed in each detail table, 0 lists all.
+func PrintTradeDiff(diff report.TradeDiff, a, b string, limit int, opts Options) {
+	r := newTableRenderer(opts, "diff")
+
+	tSummary := table.NewWriter()
+	tSummary.AppendHeader(table.Row{"", "A: " + a, "B: " + b, "Δ"})
+	tSummary.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 2, Align: text.AlignRight},
+		{Number: 3, Align: text.AlignRight},
+		{Number: 4, Align: text.AlignRight},
+	})
+	tSummary.AppendRows([]table.Row{
+		{"Trades", diff.TradesA, diff.TradesB, fmt.Sprintf("%+d", diff.TradesB-diff.TradesA)},
+		{"Absolute profit", fmt.Sprintf("%.3f", diff.ProfitA), fmt.Sprintf("%.3f", diff.ProfitB), fmt.Sprintf("%+.3f", diff.ProfitB-diff.ProfitA)},
+		{"Matched trades", diff.Matched, diff.Matched, ""},
+		{"Changed exits", len(diff.Changed), len(diff.Changed), ""},
+		{"Only in A", len(diff.OnlyA), "", ""},
+		{"Only in B", "", len(diff.OnlyB), ""},
+	})
+	r.Render(tSummary, "summary")
+
+	if len(diff.Changed) > 0 {
+		tChanged := table.NewWriter()
+		tChanged.SetTitle("Changed exits")
+		tChanged.AppendHeader(table.Row{"Pair", "Side", "Open date", "Exit A", "Exit B", "Close A", "Close B", "Profit A", "Profit B", "Δ Profit"})
+		tChanged.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "Profit A", Align: text.AlignRight},
+			{Name: "Profit B", Align: text.AlignRight},
+			{Name: "Δ Profit", Align: text.AlignRight},
+		})
+		for i, c := range diff.Changed {
+			if limit > 0 && i >= limit {
+				tChanged.SetCaption("%d more changed trades", len(diff.Changed)-limit)
+				break
+			}
+			tChanged.AppendRow(table.Row{
+				c.Pair,
+				side(c.IsShort),
+				c.OpenDate.Format(backtest.DateTimeFormat),
+				c.ExitReasonA,
+				c.ExitReasonB,
+				formatDate(c.CloseDateA),
+				formatDate(c.CloseDateB),
+				fmt.Sprintf("%.3f", c.ProfitA),
+				fmt.Sprintf("%.3f", c.ProfitB),
+				fmt.Sprintf("%+.3f", c.ProfitDelta()),
+			})
+		}
+		r.Render(tChanged, "changed")
+	}
+
+	for _, only := range []struct {
+		title  string
+		name   string
+		trades []backtest.Trade
+	}{
+		{title: "Only in A", name: "only-a", trades: diff.OnlyA},
+		{title: "Only in B", name: "only-b", trades: diff.OnlyB},
+	} {
+		if len(only.trades) == 0 {
+			continue
+		}
+
+		tOnly := table.NewWriter()
+		tOnly.SetTitle(only.title)
+		tOnly.AppendHeader(table.Row{"Pair", "Side", "Open date", "Close date", "Exit reason", "Profit"})
+		tOnly.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "Profit", Align: text.AlignRight},
+		})
+		for i, t := range only.trades {
+			if limit > 0 && i >= limit {
+				tOnly.SetCaption("%d more trades", len(only.trades)-limit)
+				break
+			}
+			tOnly.AppendRow(table.Row{
+				t.Pair,
+				side(t.IsShort),
+				t.OpenDate.Format(backtest.DateTimeFormat),
+				formatDate(t.CloseDate.Time),
+				t.ExitReason,
+				fmt.Sprintf("%.3f", t.ProfitAbs),
+			})
+		}
+		r.Render(tOnly, only.name)
+	}
+}
+
+// side returns the side of a trade
+func side(isShort bool) string {
+	if isShort {
+		return "short"
+	}
+
+	return "long"
+}
+
+// formatDate formats a date, zero dates such as the close date of open trades are left empty
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(backtest.DateTimeFormat)
+}
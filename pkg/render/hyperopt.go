@@ -0,0 +1,79 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// PrintTopEpochs renders the top epochs with their parameters
+func PrintTopEpochs(epochs []backtest.HyperoptEpoch, top int, opts Options) {
+	r := newTableRenderer(opts, "hyperopt")
+
+	tEpochs := table.NewWriter()
+	tEpochs.AppendHeader(table.Row{"#", "Epoch", "Trades", "Profit %", "Max DD %", "Profit factor", "Loss", "Score", "Params"})
+	tEpochs.SetColumnConfigs([]table.ColumnConfig{
+		{Name: "Epoch", Align: text.AlignRight},
+		{Name: "Trades", Align: text.AlignRight},
+		{Name: "Profit %", Align: text.AlignRight},
+		{Name: "Max DD %", Align: text.AlignRight},
+		{Name: "Profit factor", Align: text.AlignRight},
+		{Name: "Loss", Align: text.AlignRight},
+		{Name: "Score", Align: text.AlignRight},
+	})
+
+	for i, e := range epochs {
+		if i >= top {
+			break
+		}
+
+		params := make([]string, 0, len(e.ParamsDict))
+		for k, v := range e.ParamsDict {
+			params = append(params, fmt.Sprintf("%s=%v", k, v))
+		}
+		sort.Strings(params)
+
+		s := e.ResultsMetrics
+		tEpochs.AppendRow(table.Row{
+			i + 1,
+			e.CurrentEpoch,
+			s.TotalTrades,
+			fmt.Sprintf("%.2f", s.ProfitTotal*100),
+			fmt.Sprintf("%.2f", s.DrawdownRelative*100),
+			fmt.Sprintf("%.2f", s.ProfitFactor),
+			fmt.Sprintf("%.5f", e.Loss),
+			fmt.Sprintf("%.3f", e.Score),
+			strings.Join(params, " "),
+		})
+	}
+
+	r.Render(tEpochs, "top-epochs")
+}
+
+// PrintParamSensitivity renders how the score changes across values of each hyperparameter
+func PrintParamSensitivity(sensitivities []report.ParamSensitivity, opts Options) {
+	r := newTableRenderer(opts, "hyperopt")
+
+	tSensitivity := table.NewWriter()
+	tSensitivity.AppendHeader(table.Row{"Param", "Value", "Epochs", "Mean score", "Best score"})
+	tSensitivity.SetColumnConfigs([]table.ColumnConfig{
+		{Name: "Param", AutoMerge: true},
+		{Name: "Epochs", Align: text.AlignRight},
+		{Name: "Mean score", Align: text.AlignRight},
+		{Name: "Best score", Align: text.AlignRight},
+	})
+
+	for _, ps := range sensitivities {
+		for _, g := range ps.Groups {
+			tSensitivity.AppendRow(table.Row{ps.Param, g.Value, g.Epochs, fmt.Sprintf("%.3f", g.MeanScore), fmt.Sprintf("%.3f", g.BestScore)})
+		}
+		tSensitivity.AppendSeparator()
+	}
+
+	r.Render(tSensitivity, "param-sensitivity")
+}
@@ -0,0 +1,28 @@
+package render
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+)
+
+// JSONOutput is the document written by analyze --format json and served by the serve command
+type JSONOutput struct {
+	Results []JSONResult `json:"results"`
+}
+
+// JSONResult holds the reports computed for a single backtest result file
+type JSONResult struct {
+	File       string                   `json:"file"`
+	Summary    []report.StrategySummary `json:"summary"`
+	Strategies []report.StrategyReport  `json:"strategies"`
+}
+
+// WriteJSON writes the JSONOutput to w
+func WriteJSON(w io.Writer, output JSONOutput) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(output)
+}
@@ -0,0 +1,60 @@
+package render
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// leaderboardMetrics are the metrics the leaderboard always shows,
+// from the Trades column to the Score column.
+var leaderboardMetrics = []string{"total_trades", "profit_total", "max_relative_drawdown", "profit_factor", "sharpe", "score"}
+
+// PrintLeaderboard renders the leaderboard entries,
+// the ranking metric gets its own column unless the leaderboard already shows it.
+func PrintLeaderboard(entries []report.LeaderboardEntry, metric string, opts Options) {
+	r := newTableRenderer(opts, "leaderboard")
+	shown := slices.Contains(leaderboardMetrics, metric)
+
+	tLeaderboard := table.NewWriter()
+	header := table.Row{"#", "File", "Strategy", "Timerange", "Trades", "Profit %", "Max DD %", "Profit factor", "Sharpe", "Score"}
+	if !shown {
+		header = append(header, metric)
+	}
+	tLeaderboard.AppendHeader(header)
+	tLeaderboard.SetColumnConfigs([]table.ColumnConfig{
+		{Name: "Trades", Align: text.AlignRight},
+		{Name: "Profit %", Align: text.AlignRight},
+		{Name: "Max DD %", Align: text.AlignRight},
+		{Name: "Profit factor", Align: text.AlignRight},
+		{Name: "Sharpe", Align: text.AlignRight},
+		{Name: "Score", Align: text.AlignRight},
+		{Name: metric, Align: text.AlignRight},
+	})
+
+	for i, e := range entries {
+		s := e.Strategy
+		timerange := fmt.Sprintf("%s-%s", s.BacktestStart.Format("20060102"), s.BacktestEnd.Format("20060102"))
+		row := table.Row{
+			i + 1,
+			e.File,
+			e.Name,
+			timerange,
+			s.TotalTrades,
+			fmt.Sprintf("%.2f", s.ProfitTotal*100),
+			fmt.Sprintf("%.2f", s.DrawdownRelative*100),
+			fmt.Sprintf("%.2f", s.ProfitFactor),
+			fmt.Sprintf("%.2f", s.Sharpe),
+			fmt.Sprintf("%.3f", e.Score),
+		}
+		if !shown {
+			row = append(row, fmt.Sprintf("%.4f", e.Value))
+		}
+		tLeaderboard.AppendRow(row)
+	}
+
+	r.Render(tLeaderboard, "table")
+}
@@ -0,0 +1,45 @@
+package render
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+)
+
+func TestPrintLeaderboard(t *testing.T) {
+	columns := []string{"#", "File", "Strategy", "Timerange", "Trades", "Profit %", "Max DD %", "Profit factor", "Sharpe", "Score"}
+	entries := []report.LeaderboardEntry{
+		{File: "backtest-result", Name: "SampleStrategy", Value: 1.5, Score: 0.4, Strategy: backtest.Strategy{Sharpe: 1.5, Sortino: 2}},
+	}
+
+	testCases := []struct {
+		metric   string
+		expected []string
+	}{
+		{metric: "score", expected: columns},
+		{metric: "sharpe", expected: columns},
+		{metric: "profit_factor", expected: columns},
+		{metric: "sortino", expected: append(columns, "sortino")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.metric, func(t *testing.T) {
+			dir := t.TempDir()
+			PrintLeaderboard(entries, tc.metric, Options{Format: "csv", OutputDir: dir})
+
+			data, err := os.ReadFile(filepath.Join(dir, "leaderboard_table.csv"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			header, _, _ := strings.Cut(string(data), "\n")
+			if columns := strings.Split(header, ","); !reflect.DeepEqual(columns, tc.expected) {
+				t.Errorf("expected columns %v, got %v", tc.expected, columns)
+			}
+		})
+	}
+}
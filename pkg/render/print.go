@@ -0,0 +1,607 @@
+package render
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// Print renders the selected reports of the strategies of a backtest result as tables,
+// preceded by a strategy summary when the result holds several strategies or differs from freqtrade strategy comparison.
+func Print(br *backtest.BacktestResult, reports []report.StrategyReport, opts Options) {
+	summaries := report.Summary(br, reports)
+	showSummary := len(reports) > 1
+	for _, v := range summaries {
+		if v.CrossCheck != nil && v.CrossCheck.Mismatch() {
+			showSummary = true
+		}
+	}
+	if showSummary {
+		PrintSummary(summaries, newTableRenderer(opts, br.Name()))
+	}
+
+	for _, strategyReport := range reports {
+		s := br.Strategy[strategyReport.Strategy]
+		s.SortMinimalROI()
+		r := newTableRenderer(opts, br.Name()+"_"+strategyReport.Strategy)
+
+		c := ReportContext{
+			Report:   strategyReport,
+			Strategy: s,
+			Options:  opts,
+		}
+		for _, name := range opts.selection() {
+			if render, ok := renderers[name]; ok {
+				render(r, c)
+			}
+		}
+	}
+}
+
+// transformers format the values displayed in report tables
+type transformers struct {
+	number         text.Transformer
+	minuteDuration text.Transformer
+	secondDuration text.Transformer
+	percentage     text.Transformer
+	float          text.Transformer
+	price          text.Transformer
+}
+
+// newTransformers returns the transformers used to display values of the Strategy
+func newTransformers(s backtest.Strategy) transformers {
+	return transformers{
+		number: text.NewNumberTransformer("%.2f"),
+		minuteDuration: func(val interface{}) string {
+			d, err := time.ParseDuration(fmt.Sprintf("%vm", val))
+			if err != nil {
+				return "error"
+			}
+			return d.String()
+		},
+		secondDuration: func(val interface{}) string {
+			d, err := time.ParseDuration(fmt.Sprintf("%vs", val))
+			if err != nil {
+				return "error"
+			}
+			return d.String()
+		},
+		percentage: func(val interface{}) string {
+			v, ok := val.(float64)
+			if !ok {
+				return "error"
+			}
+			return fmt.Sprintf("%.2f%%", v*100)
+		},
+		float: func(val interface{}) string {
+			return fmt.Sprintf("%.2f", val)
+		},
+		price: func(val interface{}) string {
+			return fmt.Sprintf("%.3f %s", val, s.StakeCurrency)
+		},
+	}
+}
+
+// exitReasonColumnConfigs returns the column configs of exit reason tables
+func exitReasonColumnConfigs(tf transformers) []table.ColumnConfig {
+	return []table.ColumnConfig{
+		{Name: "Exits", Align: text.AlignRight},
+		{Name: "Avg Profit %", Align: text.AlignRight, Transformer: tf.number},
+		{Name: "Tot Profit", Align: text.AlignRight, Transformer: tf.number},
+		{Name: "Tot Profit %", Align: text.AlignRight, Transformer: tf.number},
+		{Name: "Avg Duration", Align: text.AlignRight, Transformer: tf.minuteDuration},
+		{Name: "StdDev Duration", Align: text.AlignRight, Transformer: tf.minuteDuration},
+	}
+}
+
+// printExits renders the exit reason report
+func printExits(r TableRenderer, c ReportContext) {
+	tExits := table.NewWriter()
+	tExits.AppendHeader(table.Row{"Exit Reason", "Exits", "Avg Profit %", "Tot Profit", "Tot Profit %", "Avg Duration", "StdDev Duration"})
+	tExits.SetColumnConfigs(exitReasonColumnConfigs(newTransformers(c.Strategy)))
+	tExits.SortBy([]table.SortBy{
+		{Name: "Exits", Mode: table.DscNumeric},
+	})
+	appendExitRow(tExits, c.Report.ExitReasonReports, false)
+	r.Render(tExits, "exits")
+}
+
+// printROIExits renders the roi exits report, broken down by roi step
+func printROIExits(r TableRenderer, c ReportContext) {
+	tROIExits := table.NewWriter()
+	tROIExits.AppendHeader(table.Row{"ROI exit Reason", "Exits", "Avg Profit %", "Tot Profit", "Tot Profit %", "Avg Duration", "StdDev Duration"})
+	tROIExits.SetColumnConfigs(exitReasonColumnConfigs(newTransformers(c.Strategy)))
+	tROIExits.SortBy([]table.SortBy{
+		{Name: "Exits", Mode: table.DscNumeric},
+	})
+	appendExitRow(tROIExits, c.Report.ExitReasonReports, true)
+	r.Render(tROIExits, "roi-exits")
+}
+
+// printPairs renders the per-pair report
+func printPairs(r TableRenderer, c ReportContext) {
+	tf := newTransformers(c.Strategy)
+
+	err := c.Report.PairReports.SortBy(c.Options.PairSort)
+	if err != nil {
+		log.Printf("> WARNING: %v\n", err)
+	}
+
+	tPairs := table.NewWriter()
+	tPairs.AppendHeader(table.Row{"Pair", "Trades", "Avg Profit %", "Tot Profit", "Tot Profit %", "Win %", "Avg Duration", "Worst Trade %", "DD Contrib %"})
+	tPairs.SetColumnConfigs([]table.ColumnConfig{
+		{Name: "Trades", Align: text.AlignRight},
+		{Name: "Avg Profit %", Align: text.AlignRight, Transformer: tf.number},
+		{Name: "Tot Profit", Align: text.AlignRight, Transformer: tf.price},
+		{Name: "Tot Profit %", Align: text.AlignRight, Transformer: tf.number},
+		{Name: "Win %", Align: text.AlignRight, Transformer: tf.float},
+		{Name: "Avg Duration", Align: text.AlignRight, Transformer: tf.minuteDuration},
+		{Name: "Worst Trade %", Align: text.AlignRight, Transformer: tf.number},
+		{Name: "DD Contrib %", Align: text.AlignRight, Transformer: tf.float},
+	})
+	for _, v := range c.Report.PairReports {
+		tPairs.AppendRow([]interface{}{v.Pair, v.Trades, v.AvgProfit, v.TotalProfit, v.TotalProfitPercentage, v.WinRate, v.AvgDuration, v.WorstTrade, v.DrawdownContribution})
+	}
+	r.Render(tPairs, "pairs")
+}
+
+// printEnterTags renders the enter tag reports, nested as enter tag -> exit reason -> roi step and as a matrix
+func printEnterTags(r TableRenderer, c ReportContext) {
+	tf := newTransformers(c.Strategy)
+
+	// Enter tag report, nested as enter tag -> exit reason -> ROI step
+	tEnterTags := table.NewWriter()
+	tEnterTags.AppendHeader(table.Row{"Enter Tag / Exit Reason", "Exits", "Avg Profit %", "Tot Profit", "Tot Profit %", "Avg Duration", "StdDev Duration"})
+	tEnterTags.SetColumnConfigs(exitReasonColumnConfigs(tf))
+	appendNestedRow(tEnterTags, c.Report.EnterTagReports, 0)
+	r.Render(tEnterTags, "enter-tags")
+
+	// Enter tag x exit reason matrix
+	exitReasons := c.Report.EnterTagReports.ExitReasons(1)
+	tEnterTagMatrix := table.NewWriter()
+	header := table.Row{"Enter Tag \\ Exit Reason"}
+	for _, reason := range exitReasons {
+		header = append(header, reason)
+	}
+	tEnterTagMatrix.AppendHeader(header)
+	for _, tag := range c.Report.EnterTagReports {
+		row := table.Row{tag.Reason}
+		for _, reason := range exitReasons {
+			er := tag.ExitReasonReports.GetReportIndexByReason(reason)
+			if er == nil {
+				row = append(row, "-")
+				continue
+			}
+			row = append(row, fmt.Sprintf("%d / %.2f", er.Exits, er.TotalProfit))
+		}
+		tEnterTagMatrix.AppendRow(row)
+	}
+	tEnterTagMatrix.SetCaption("exits / tot profit")
+	r.Render(tEnterTagMatrix, "enter-tag-matrix")
+}
+
+// printBreakdowns renders the periodic breakdown reports
+func printBreakdowns(r TableRenderer, c ReportContext) {
+	tf := newTransformers(c.Strategy)
+
+	for _, br := range c.Report.BreakdownReports {
+		tBreakdown := table.NewWriter()
+		tBreakdown.SetTitle(strings.ToUpper(br.Period[:1]) + br.Period[1:] + " breakdown")
+		tBreakdown.AppendHeader(table.Row{br.Period, "Trades", "Tot Profit", "Profit %", "Wins", "Draws", "Losses", "Balance"})
+		tBreakdown.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "Trades", Align: text.AlignRight},
+			{Name: "Tot Profit", Align: text.AlignRight, Transformer: tf.price},
+			{Name: "Profit %", Align: text.AlignRight, Transformer: tf.percentage},
+			{Name: "Wins", Align: text.AlignRight},
+			{Name: "Draws", Align: text.AlignRight},
+			{Name: "Losses", Align: text.AlignRight},
+			{Name: "Balance", Align: text.AlignRight, Transformer: tf.price},
+		})
+		for _, v := range br.Periods {
+			tBreakdown.AppendRow([]interface{}{v.Name, v.Trades, v.ProfitAbs, v.Profit, v.Wins, v.Draws, v.Losses, v.Balance})
+		}
+		r.Render(tBreakdown, "breakdown-"+br.Period)
+	}
+}
+
+// printDrawdowns renders the drawdown episodes report
+func printDrawdowns(r TableRenderer, c ReportContext) {
+	tf := newTransformers(c.Strategy)
+
+	if c.Options.Drawdowns > 0 {
+		tDrawdowns := table.NewWriter()
+		tDrawdowns.AppendHeader(table.Row{"#", "Start", "Trough", "Recovery", "High", "Low", "Depth", "Depth %", "Duration", "Time to recover"})
+		tDrawdowns.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "High", Align: text.AlignRight, Transformer: tf.price},
+			{Name: "Low", Align: text.AlignRight, Transformer: tf.price},
+			{Name: "Depth", Align: text.AlignRight, Transformer: tf.price},
+			{Name: "Depth %", Align: text.AlignRight, Transformer: tf.percentage},
+			{Name: "Duration", Align: text.AlignRight},
+			{Name: "Time to recover", Align: text.AlignRight},
+		})
+		for i, v := range c.Report.DrawdownReport.Episodes {
+			if i >= c.Options.Drawdowns {
+				break
+			}
+			recovery, timeToRecover := "-", "-"
+			if v.Recovered {
+				recovery = v.Recovery.Format(backtest.DateTimeFormat)
+				timeToRecover = v.TimeToRecover.String()
+			}
+			tDrawdowns.AppendRow([]interface{}{i + 1, v.Start.Format(backtest.DateTimeFormat), v.Trough.Format(backtest.DateTimeFormat), recovery, v.High, v.Low, v.Depth, v.DepthRelative, v.Duration.String(), timeToRecover})
+		}
+		if len(c.Report.DrawdownReport.Warnings) > 0 {
+			tDrawdowns.SetCaption("WARNING: %s", strings.Join(c.Report.DrawdownReport.Warnings, ", "))
+		}
+		r.Render(tDrawdowns, "drawdowns")
+	}
+}
+
+// printROISimulation renders the roi table what-if report
+func printROISimulation(r TableRenderer, c ReportContext) {
+	s := c.Strategy
+	tf := newTransformers(c.Strategy)
+
+	if sim := c.Report.ROISimulation; sim != nil {
+		tROISim := table.NewWriter()
+		tROISim.SetTitle("ROI what-if: %s (actual: %s)", backtest.MinimalROIFromMap(sim.MinimalROI).String(), s.MinimalROISorted.String())
+		tROISim.AppendHeader(table.Row{"Trades", "Count", "Actual profit", "Projected low", "Projected high"})
+		tROISim.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "Count", Align: text.AlignRight},
+			{Name: "Actual profit", Align: text.AlignRight, Transformer: tf.price},
+			{Name: "Projected low", Align: text.AlignRight, Transformer: tf.price},
+			{Name: "Projected high", Align: text.AlignRight, Transformer: tf.price},
+		})
+		for _, c := range sim.Categories {
+			tROISim.AppendRow([]interface{}{c.Category, c.Trades, c.ActualProfit, c.ProjectedLow, c.ProjectedHigh})
+		}
+		tROISim.AppendFooter(table.Row{"Total", sim.Trades, tf.price(sim.ActualProfit), tf.price(sim.ProjectedLow), tf.price(sim.ProjectedHigh)})
+		r.Render(tROISim, "roi-simulation")
+	}
+}
+
+// printStoplossSimulation renders the stoploss what-if report
+func printStoplossSimulation(r TableRenderer, c ReportContext) {
+	tf := newTransformers(c.Strategy)
+
+	if sim := c.Report.StoplossSimulation; sim != nil {
+		title := fmt.Sprintf("Stoploss what-if (actual: %.4f)", sim.Stoploss)
+		if sim.Trailing != nil {
+			title = fmt.Sprintf("%s, trailing stop %.4f after %.4f", title, sim.Trailing.Positive, sim.Trailing.Offset)
+		}
+
+		maxProfit := 0.0
+		for _, v := range sim.Results {
+			maxProfit = math.Max(maxProfit, math.Abs(v.ProjectedProfit))
+		}
+
+		tStoploss := table.NewWriter()
+		tStoploss.SetTitle(title)
+		tStoploss.AppendHeader(table.Row{"Stoploss", "Stopped out", "Tightened", "Saved", "Trailing exits", "Stopped impact", "Tightened impact", "Saved impact (worst)", "Trailing impact", "Net impact", "Projected profit", "Curve"})
+		tStoploss.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "Stoploss", Align: text.AlignRight},
+			{Name: "Stopped out", Align: text.AlignRight},
+			{Name: "Tightened", Align: text.AlignRight},
+			{Name: "Saved", Align: text.AlignRight},
+			{Name: "Trailing exits", Align: text.AlignRight},
+			{Name: "Stopped impact", Align: text.AlignRight, Transformer: tf.number},
+			{Name: "Tightened impact", Align: text.AlignRight, Transformer: tf.number},
+			{Name: "Saved impact (worst)", Align: text.AlignRight, Transformer: tf.number},
+			{Name: "Trailing impact", Align: text.AlignRight, Transformer: tf.number},
+			{Name: "Net impact", Align: text.AlignRight, Transformer: tf.number},
+			{Name: "Projected profit", Align: text.AlignRight, Transformer: tf.price},
+		})
+		for _, v := range sim.Results {
+			tStoploss.AppendRow([]interface{}{fmt.Sprintf("%.4f", v.Stoploss), v.StoppedOut, v.Tightened, v.Saved, v.TrailingExits, v.StoppedOutImpact, v.TightenedImpact, v.SavedImpact, v.TrailingImpact, v.NetImpact, v.ProjectedProfit, Bar(v.ProjectedProfit, maxProfit, 30)})
+		}
+		r.Render(tStoploss, "stoploss-simulation")
+	}
+}
+
+// printExcursions renders the MAE/MFE report and the profit left on the table
+func printExcursions(r TableRenderer, c ReportContext) {
+	tf := newTransformers(c.Strategy)
+
+	tExcursions := table.NewWriter()
+	tExcursions.SetTitle("MAE / MFE by exit reason")
+	tExcursions.AppendHeader(table.Row{"Exit Reason", "Exits", "Avg MAE %", "Worst MAE %", "Avg MFE %", "Best MFE %", "Avg Left %", "Median Left %", "P90 Left %"})
+	tExcursions.SetColumnConfigs([]table.ColumnConfig{
+		{Name: "Exits", Align: text.AlignRight},
+		{Name: "Avg MAE %", Align: text.AlignRight, Transformer: tf.float},
+		{Name: "Worst MAE %", Align: text.AlignRight, Transformer: tf.float},
+		{Name: "Avg MFE %", Align: text.AlignRight, Transformer: tf.float},
+		{Name: "Best MFE %", Align: text.AlignRight, Transformer: tf.float},
+		{Name: "Avg Left %", Align: text.AlignRight, Transformer: tf.float},
+		{Name: "Median Left %", Align: text.AlignRight, Transformer: tf.float},
+		{Name: "P90 Left %", Align: text.AlignRight, Transformer: tf.float},
+	})
+	appendExcursionRow(tExcursions, c.Report.ExitReasonReports, 0)
+	r.Render(tExcursions, "excursions")
+
+	maxCount := 0.0
+	for _, b := range c.Report.LeftOnTable {
+		maxCount = math.Max(maxCount, float64(b.Count))
+	}
+	tLeftOnTable := table.NewWriter()
+	tLeftOnTable.SetTitle("Profit left on the table (MFE - realized)")
+	tLeftOnTable.AppendHeader(table.Row{"Left %", "Trades", "Distribution"})
+	tLeftOnTable.SetColumnConfigs([]table.ColumnConfig{
+		{Name: "Trades", Align: text.AlignRight},
+	})
+	for i, b := range c.Report.LeftOnTable {
+		label := fmt.Sprintf("%.1f - %.1f", b.Low, b.High)
+		if i == len(c.Report.LeftOnTable)-1 {
+			label = fmt.Sprintf("%.1f+", b.Low)
+		}
+		tLeftOnTable.AppendRow([]interface{}{label, b.Count, Bar(float64(b.Count), maxCount, 40)})
+	}
+	r.Render(tLeftOnTable, "left-on-table")
+}
+
+// printHistograms renders the profit and duration distributions by exit reason
+func printHistograms(r TableRenderer, c ReportContext) {
+	tf := newTransformers(c.Strategy)
+
+	for _, d := range []struct {
+		name        string
+		title       string
+		transformer text.Transformer
+		values      func(er report.ExitReasonReport) []float64
+		stats       func(er report.ExitReasonReport) report.Distribution
+	}{
+		{
+			name:        "profit-distribution",
+			title:       "Profit distribution by exit reason",
+			transformer: tf.price,
+			values:      func(er report.ExitReasonReport) []float64 { return er.ProfitAbs },
+			stats:       func(er report.ExitReasonReport) report.Distribution { return er.ProfitDistribution },
+		},
+		{
+			name:        "duration-distribution",
+			title:       "Duration distribution by exit reason",
+			transformer: tf.minuteDuration,
+			values: func(er report.ExitReasonReport) []float64 {
+				var durations []float64
+				for _, v := range er.TradeDurations {
+					durations = append(durations, float64(v))
+				}
+				return durations
+			},
+			stats: func(er report.ExitReasonReport) report.Distribution { return er.DurationDistribution },
+		},
+	} {
+		tStats := table.NewWriter()
+		tStats.SetTitle(d.title)
+		tStats.AppendHeader(table.Row{"Exit Reason", "Min", "P5", "P25", "Median", "P75", "P95", "Max", "Skew", "Kurtosis"})
+		columnConfigs := []table.ColumnConfig{
+			{Name: "Skew", Align: text.AlignRight, Transformer: tf.float},
+			{Name: "Kurtosis", Align: text.AlignRight, Transformer: tf.float},
+		}
+		for _, name := range []string{"Min", "P5", "P25", "Median", "P75", "P95", "Max"} {
+			columnConfigs = append(columnConfigs, table.ColumnConfig{Name: name, Align: text.AlignRight, Transformer: d.transformer})
+		}
+		tStats.SetColumnConfigs(columnConfigs)
+
+		tHistogram := table.NewWriter()
+		tHistogram.AppendHeader(table.Row{"Exit Reason", "From", "To", "Trades", "Histogram"})
+		tHistogram.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "Exit Reason", AutoMerge: true},
+			{Name: "From", Align: text.AlignRight, Transformer: d.transformer},
+			{Name: "To", Align: text.AlignRight, Transformer: d.transformer},
+			{Name: "Trades", Align: text.AlignRight},
+		})
+
+		for _, er := range c.Report.ExitReasonReports {
+			v := d.stats(er)
+			tStats.AppendRow([]interface{}{er.Reason, v.Min, v.P5, v.P25, v.Median, v.P75, v.P95, v.Max, v.Skew, v.Kurtosis})
+
+			buckets := report.Histogram(d.values(er), 10)
+			maxCount := 0.0
+			for _, b := range buckets {
+				maxCount = math.Max(maxCount, float64(b.Count))
+			}
+			for _, b := range buckets {
+				tHistogram.AppendRow([]interface{}{er.Reason, b.Low, b.High, b.Count, Bar(float64(b.Count), maxCount, 40)})
+			}
+			tHistogram.AppendSeparator()
+		}
+
+		r.Render(tStats, d.name)
+		r.Render(tHistogram, d.name+"-histogram")
+	}
+}
+
+// printMonteCarlo renders the Monte Carlo report
+func printMonteCarlo(r TableRenderer, c ReportContext) {
+	tf := newTransformers(c.Strategy)
+
+	if mc := c.Report.MonteCarlo; mc != nil {
+		tMonteCarlo := table.NewWriter()
+		tMonteCarlo.SetTitle("Monte Carlo: %d simulations (%s, seed %d)", mc.Simulations, mc.Mode, mc.Seed)
+		tMonteCarlo.AppendHeader(table.Row{"Metric", "Actual", "P5", "P25", "Median", "P75", "P95", "Worst"})
+		columnConfigs := []table.ColumnConfig{}
+		for _, name := range []string{"Actual", "P5", "P25", "Median", "P75", "P95", "Worst"} {
+			columnConfigs = append(columnConfigs, table.ColumnConfig{Name: name, Align: text.AlignRight})
+		}
+		tMonteCarlo.SetColumnConfigs(columnConfigs)
+
+		fb := mc.FinalBalance
+		tMonteCarlo.AppendRow([]interface{}{"Final balance", tf.price(mc.Actual.FinalBalance), tf.price(fb.P5), tf.price(fb.P25), tf.price(fb.Median), tf.price(fb.P75), tf.price(fb.P95), tf.price(fb.Min)})
+		dd := mc.MaxDrawdownAbs
+		tMonteCarlo.AppendRow([]interface{}{"Max drawdown", tf.price(mc.Actual.MaxDrawdownAbs), tf.price(dd.P5), tf.price(dd.P25), tf.price(dd.Median), tf.price(dd.P75), tf.price(dd.P95), tf.price(dd.Max)})
+		ddr := mc.MaxDrawdownRelative
+		tMonteCarlo.AppendRow([]interface{}{"Max drawdown %", tf.percentage(mc.Actual.MaxDrawdownRelative), tf.percentage(ddr.P5), tf.percentage(ddr.P25), tf.percentage(ddr.Median), tf.percentage(ddr.P75), tf.percentage(ddr.P95), tf.percentage(ddr.Max)})
+		ls := mc.LongestLosingStreak
+		tMonteCarlo.AppendRow([]interface{}{"Longest losing streak", mc.Actual.LongestLosingStreak, ls.P5, ls.P25, ls.Median, ls.P75, ls.P95, ls.Max})
+		tMonteCarlo.SetCaption("90%% confidence interval is P5 - P95, probability of loss: %.2f%%", mc.LossProbability*100)
+		r.Render(tMonteCarlo, "montecarlo")
+	}
+}
+
+// printBootstrap renders the bootstrap report
+func printBootstrap(r TableRenderer, c ReportContext) {
+	if bs := c.Report.Bootstrap; bs != nil {
+		tBootstrap := table.NewWriter()
+		tBootstrap.SetTitle("Bootstrap: %d samples (seed %d)", bs.Samples, bs.Seed)
+		tBootstrap.AppendHeader(table.Row{"Metric", "Freqtrade", "Recomputed", "Mean", "Low", "Median", "High", "Samples"})
+		columnConfigs := []table.ColumnConfig{}
+		for _, name := range []string{"Freqtrade", "Recomputed", "Mean", "Low", "Median", "High", "Samples"} {
+			columnConfigs = append(columnConfigs, table.ColumnConfig{Name: name, Align: text.AlignRight})
+		}
+		tBootstrap.SetColumnConfigs(columnConfigs)
+		for _, bi := range bs.Intervals {
+			tBootstrap.AppendRow([]interface{}{bi.Metric, fmt.Sprintf("%.3f", bi.Actual), fmt.Sprintf("%.3f", bi.Recomputed), fmt.Sprintf("%.3f", bi.Mean), fmt.Sprintf("%.3f", bi.Low), fmt.Sprintf("%.3f", bi.Median), fmt.Sprintf("%.3f", bi.High), bi.Samples})
+		}
+		tBootstrap.SetCaption("Low - High is the %g%% confidence interval, recomputed from resampled trades", bs.Confidence*100)
+		r.Render(tBootstrap, "bootstrap")
+	}
+}
+
+// printWinLoss renders the win loss report
+func printWinLoss(r TableRenderer, c ReportContext) {
+	s := c.Strategy
+	tf := newTransformers(c.Strategy)
+
+	tWinLoss := table.NewWriter()
+	tWinLoss.SetColumnConfigs([]table.ColumnConfig{
+		{Name: "Entries", Align: text.AlignRight},
+		{Name: "Avg Profit %", Align: text.AlignRight, Transformer: tf.float},
+		{Name: "Cum Profit", Align: text.AlignRight, Transformer: tf.float},
+		{Name: "Tot Profit USDT", Align: text.AlignRight, Transformer: tf.price},
+		{Name: "Tot Profit %", Align: text.AlignRight, Transformer: tf.percentage},
+		{Name: "Avg Duration", Align: text.AlignRight, Transformer: tf.secondDuration},
+		{Name: "Wins", Align: text.AlignRight},
+		{Name: "Draws", Align: text.AlignRight},
+		{Name: "Loss", Align: text.AlignRight},
+		{Name: "Win %", Align: text.AlignRight, Transformer: tf.percentage},
+	})
+	tWinLoss.AppendHeader(table.Row{"TAG", "Entries", "Avg Profit %", "Cum Profit", "Tot Profit USDT", "Tot Profit %", "Avg Duration", "Win", "Draws", "Loss", "Win %"})
+	tWinLoss.AppendRow([]interface{}{"TOTAL", s.TotalTrades, s.ProfitTotalAbs / float64(s.TotalTrades), 0.0, s.ProfitTotalAbs, s.ProfitTotal, s.HoldingAvgDuration, s.Wins, s.Draws, s.Losses, float64(s.Wins) / float64(s.TotalTrades)})
+	r.Render(tWinLoss, "winloss")
+}
+
+// printMetrics renders the general metric report
+func printMetrics(r TableRenderer, c ReportContext) {
+	s := c.Strategy
+	tf := newTransformers(c.Strategy)
+
+	tMetrics := table.NewWriter()
+	tMetrics.AppendHeader(table.Row{"Metric", "Value"})
+	tMetrics.AppendRow([]interface{}{"Strategy", c.Report.Strategy})
+	tMetrics.AppendRow([]interface{}{"Minimal ROI", s.MinimalROISorted.String()})
+	tMetrics.AppendRow([]interface{}{"Stoploss", fmt.Sprintf("%.4f", s.Stoploss)})
+	tMetrics.AppendRow([]interface{}{"", ""})
+	tMetrics.AppendRow([]interface{}{"Backtest from", s.BacktestStart})
+	tMetrics.AppendRow([]interface{}{"Backtest to", s.BacktestEnd})
+	tMetrics.AppendRow([]interface{}{"Max open trades", s.MaxOpenTrades})
+	tMetrics.AppendRow([]interface{}{"", ""})
+	tMetrics.AppendRow([]interface{}{"Total/Daily Avg Trades", fmt.Sprintf("%d / %.2f", s.TotalTrades, float64(s.TotalTrades)/float64(s.BacktestDays))})
+	tMetrics.AppendRow([]interface{}{"Starting balance", tf.price(s.StartingBalance)})
+	tMetrics.AppendRow([]interface{}{"Final balance", tf.price(s.FinalBalance)})
+	tMetrics.AppendRow([]interface{}{"Absolute profit", tf.price(s.ProfitTotalAbs)})
+	tMetrics.AppendRow([]interface{}{"Total profit %", tf.percentage(s.ProfitTotal)})
+	tMetrics.AppendRow([]interface{}{"Avg profit %", tf.percentage(s.ProfitMean)})
+	tMetrics.AppendRow([]interface{}{"CAGR %", tf.percentage(s.CAGR)})
+	tMetrics.AppendRow([]interface{}{"Sortino", tf.float(s.Sortino)})
+	tMetrics.AppendRow([]interface{}{"Sharpe", tf.float(s.Sharpe)})
+	tMetrics.AppendRow([]interface{}{"Calmar", tf.float(s.Calmar)})
+	tMetrics.AppendRow([]interface{}{"Profit factor", tf.float(s.ProfitFactor)})
+	tMetrics.AppendRow([]interface{}{"Expectancy", tf.float(s.Expectancy)})
+	tMetrics.AppendRow([]interface{}{"Trades per day", tf.float(s.TradesPerDay)})
+	tMetrics.AppendRow([]interface{}{"Avg. daily profit %", fmt.Sprintf("%.2f", float64(s.ProfitTotal*100)/float64(s.BacktestDays))})
+	tMetrics.AppendRow([]interface{}{"Avg. stake amount", tf.price(s.AvgStakeAmount)})
+	tMetrics.AppendRow([]interface{}{"Total trade volume", tf.price(s.TotalVolume)})
+	tMetrics.AppendRow([]interface{}{"", ""})
+	tMetrics.AppendRow([]interface{}{"Long / Short", fmt.Sprintf("%d / %d", s.TradeCountLong, s.TradeCountShort)})
+	tMetrics.AppendRow([]interface{}{"Total profit Long %", tf.percentage(s.ProfitTotalLong)})
+	tMetrics.AppendRow([]interface{}{"Total profit Short %", tf.percentage(s.ProfitTotalShort)})
+	tMetrics.AppendRow([]interface{}{"Absolute profit Long", tf.price(s.ProfitTotalLongAbs)})
+	tMetrics.AppendRow([]interface{}{"Absolute profit Short", tf.price(s.ProfitTotalShortAbs)})
+	tMetrics.AppendRow([]interface{}{"", ""})
+	tMetrics.AppendRow([]interface{}{"Avg. Duration Winners", tf.secondDuration(s.WinnderAvgDuration)})
+	tMetrics.AppendRow([]interface{}{"Avg. Duration Loser", tf.secondDuration(s.LoserAvgDuration)})
+	tMetrics.AppendRow([]interface{}{"", ""})
+	tMetrics.AppendRow([]interface{}{"Min balance", tf.price(s.MinBalance)})
+	tMetrics.AppendRow([]interface{}{"Max balance", tf.price(s.MaxBalance)})
+	tMetrics.AppendRow([]interface{}{"Max % of account underwater", tf.percentage(s.DrawdownRelative)})
+	tMetrics.AppendRow([]interface{}{"Absolute Drawdown (Account)", tf.percentage(s.DrawdownAbsAccount)})
+	tMetrics.AppendRow([]interface{}{"Absolute Drawdown", tf.price(s.DrawdownAbs)})
+	tMetrics.AppendRow([]interface{}{"Drawdown high", tf.price(s.DrawdownHigh)})
+	tMetrics.AppendRow([]interface{}{"Drawdown low", tf.price(s.DrawdownLow)})
+	tMetrics.AppendRow([]interface{}{"Drawdown Start", s.DrawdownStart})
+	tMetrics.AppendRow([]interface{}{"Drawdown End", s.DrawdownEnd})
+	tMetrics.AppendRow([]interface{}{"Market change", tf.percentage(s.MarketChange)})
+	tMetrics.AppendRow([]interface{}{"Score", c.Report.ScoreBreakdown.Score})
+	r.Render(tMetrics, "metrics")
+}
+
+// printScore renders the score breakdown report
+func printScore(r TableRenderer, c ReportContext) {
+	tf := newTransformers(c.Strategy)
+
+	scoreBreakdown := c.Report.ScoreBreakdown
+	tScore := table.NewWriter()
+	tScore.AppendHeader(table.Row{"Score metric", "Value", "Baseline", "Direction", "Score", "Weight", "Contribution"})
+	tScore.SetColumnConfigs([]table.ColumnConfig{
+		{Name: "Value", Align: text.AlignRight, Transformer: tf.float},
+		{Name: "Baseline", Align: text.AlignRight},
+		{Name: "Score", Align: text.AlignRight, Transformer: tf.number},
+		{Name: "Weight", Align: text.AlignRight},
+		{Name: "Contribution", Align: text.AlignRight, Transformer: tf.number},
+	})
+	for _, v := range scoreBreakdown.Metrics {
+		tScore.AppendRow([]interface{}{v.Name, v.Value, v.Baseline, v.Direction, v.Score, v.Weight, v.Contribution})
+	}
+	tScore.AppendFooter(table.Row{"Total", "", "", "", "", "", fmt.Sprintf("%.2f", scoreBreakdown.Score)})
+	if scoreBreakdown.FailedRule != "" {
+		tScore.SetCaption("score forced by hard-fail rule: %s", scoreBreakdown.FailedRule)
+	}
+	r.Render(tScore, "score")
+}
+
+// appendExitRow appends reports to the table, roi selects roi steps instead of exit reasons
+func appendExitRow(t table.Writer, reports report.ExitReasonReports, roi bool) {
+	for _, v := range reports {
+		isROIStep := len(v.Reason) > 3 && strings.HasPrefix(v.Reason, "roi")
+		if isROIStep == roi {
+			t.AppendRow([]interface{}{v.Reason, v.Exits, v.AvgProfit, v.TotalProfit, v.TotalProfitPercentage, v.AvgDuration, v.StdDevDuration})
+		}
+		if len(v.ExitReasonReports) > 0 {
+			appendExitRow(t, v.ExitReasonReports, roi)
+		}
+	}
+}
+
+// appendNestedRow appends reports to the table, indenting reasons according to their depth
+func appendNestedRow(t table.Writer, reports report.ExitReasonReports, depth int) {
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].Exits > reports[j].Exits
+	})
+
+	for _, v := range reports {
+		reason := strings.Repeat("  ", depth) + v.Reason
+		t.AppendRow([]interface{}{reason, v.Exits, v.AvgProfit, v.TotalProfit, v.TotalProfitPercentage, v.AvgDuration, v.StdDevDuration})
+		if len(v.ExitReasonReports) > 0 {
+			appendNestedRow(t, v.ExitReasonReports, depth+1)
+		}
+	}
+}
+
+// appendExcursionRow appends the excursions of reports to the table, indenting reasons according to their depth
+func appendExcursionRow(t table.Writer, reports report.ExitReasonReports, depth int) {
+	for _, v := range reports {
+		reason := strings.Repeat("  ", depth) + v.Reason
+		t.AppendRow([]interface{}{reason, v.Exits, v.AvgMAE, v.WorstMAE, v.AvgMFE, v.BestMFE, v.AvgLeftOnTable, v.MedianLeftOnTable, v.P90LeftOnTable})
+		if len(v.ExitReasonReports) > 0 {
+			appendExcursionRow(t, v.ExitReasonReports, depth+1)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+)
+
+// ReportContext holds what a report needs to be rendered
+type ReportContext struct {
+	Report report.StrategyReport
+	// Strategy is the strategy the report was computed from, with its minimal roi sorted
+	Strategy backtest.Strategy
+	Options  Options
+}
+
+// RenderFunc renders a report as tables using r
+type RenderFunc func(r TableRenderer, c ReportContext)
+
+// renderers maps report names to the function rendering them
+var renderers = map[string]RenderFunc{
+	"exits":               printExits,
+	"roi":                 printROIExits,
+	"pairs":               printPairs,
+	"enter-tags":          printEnterTags,
+	"breakdown":           printBreakdowns,
+	"drawdowns":           printDrawdowns,
+	"roi-simulation":      printROISimulation,
+	"stoploss-simulation": printStoplossSimulation,
+	"excursions":          printExcursions,
+	"histograms":          printHistograms,
+	"montecarlo":          printMonteCarlo,
+	"bootstrap":           printBootstrap,
+	"freqtrade":           printCrossCheck,
+	"winloss":             printWinLoss,
+	"metrics":             printMetrics,
+	"score":               printScore,
+}
+
+// Register registers the function rendering the named report, the report must be registered
+// with report.Register first.
+func Register(name string, render RenderFunc) {
+	if _, ok := report.Lookup(name); !ok {
+		panic(fmt.Sprintf("unknown report %s", name))
+	}
+
+	renderers[name] = render
+}
+
+// selection returns the names of the reports to render
+func (o Options) selection() []string {
+	if len(o.Reports) == 0 {
+		return report.DefaultSelection()
+	}
+
+	return o.Reports
+}
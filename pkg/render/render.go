@@ -0,0 +1,105 @@
+// Package render renders reports as tables, in the terminal or as csv, markdown, html and tsv files, and as JSON.
+package render
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// Options holds the options used to render reports
+type Options struct {
+	// Format is the format used to render tables, e.g. "table", "csv", "markdown"
+	Format string
+	// OutputDir is the directory where each table is written to its own file, stdout is used when empty
+	OutputDir string
+	// PairSort is the pair report column used for sorting, e.g. "Tot Profit:desc"
+	PairSort string
+	// Drawdowns is the number of drawdown episodes to display
+	Drawdowns int
+	// Reports are the names of the reports to render in order, report.DefaultSelection is used when empty
+	Reports []string
+}
+
+// Formats maps the supported table formats to their file extension
+var Formats = map[string]string{
+	"table":    "txt",
+	"csv":      "csv",
+	"markdown": "md",
+	"html":     "html",
+	"tsv":      "tsv",
+}
+
+// TableRenderer renders tables in the configured format, either to stdout
+// or to one file per table when OutputDir is set.
+type TableRenderer struct {
+	Format    string
+	OutputDir string
+	// Prefix is prepended to the table name to build output file names
+	Prefix string
+}
+
+// newTableRenderer returns a TableRenderer using the format and output directory of opts
+func newTableRenderer(opts Options, prefix string) TableRenderer {
+	return TableRenderer{
+		Format:    opts.Format,
+		OutputDir: opts.OutputDir,
+		Prefix:    prefix,
+	}
+}
+
+// Render renders the table t, name is used to build the output file name
+func (r TableRenderer) Render(t table.Writer, name string) {
+	var output string
+	switch r.Format {
+	case "csv":
+		output = t.RenderCSV()
+	case "markdown":
+		output = t.RenderMarkdown()
+	case "html":
+		output = t.RenderHTML()
+	case "tsv":
+		output = t.RenderTSV()
+	default:
+		output = t.Render()
+	}
+
+	if r.OutputDir == "" {
+		fmt.Println(output)
+		return
+	}
+
+	ext, ok := Formats[r.Format]
+	if !ok {
+		ext = Formats["table"]
+	}
+	filename := filepath.Join(r.OutputDir, fmt.Sprintf("%s_%s.%s", r.Prefix, name, ext))
+	err := os.WriteFile(filename, []byte(output+"\n"), 0644)
+	if err != nil {
+		log.Printf("> WARNING: failed to write %s: %v\n", filename, err)
+		return
+	}
+	log.Printf("> wrote %s\n", filename)
+}
+
+// Bar returns a unicode bar representing value relative to max, using at most width characters.
+// Negative values are drawn with a lighter shade.
+func Bar(value, max float64, width int) string {
+	if max == 0 || math.IsNaN(value) {
+		return ""
+	}
+
+	if value < 0 {
+		return strings.Repeat("░", int(math.Round(-value/max*float64(width))))
+	}
+
+	// eighth blocks give a finer resolution for positive values
+	blocks := []string{"", "▏", "▎", "▍", "▌", "▋", "▊", "▉"}
+	eighths := int(math.Round(value / max * float64(width) * 8))
+	return strings.Repeat("█", eighths/8) + blocks[eighths%8]
+}
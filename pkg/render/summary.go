@@ -0,0 +1,70 @@
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// PrintSummary renders the strategy summary of a backtest result, one row per strategy.
+// A freqtrade column flags the strategies differing from freqtrade strategy comparison, when the result has one.
+func PrintSummary(summaries []report.StrategySummary, r TableRenderer) {
+	crossCheck := false
+	for _, v := range summaries {
+		if v.CrossCheck != nil {
+			crossCheck = true
+		}
+	}
+
+	tSummary := table.NewWriter()
+	tSummary.SetTitle("STRATEGY SUMMARY")
+	header := table.Row{"Strategy", "Trades", "Avg Profit %", "Tot Profit", "Tot Profit %", "Avg Duration", "Win Draw Loss Win%", "Drawdown", "Score"}
+	if crossCheck {
+		header = append(header, "freqtrade")
+	}
+	tSummary.AppendHeader(header)
+	tSummary.SetColumnConfigs([]table.ColumnConfig{
+		{Name: "Trades", Align: text.AlignRight},
+		{Name: "Avg Profit %", Align: text.AlignRight},
+		{Name: "Tot Profit", Align: text.AlignRight},
+		{Name: "Tot Profit %", Align: text.AlignRight},
+		{Name: "Avg Duration", Align: text.AlignRight},
+		{Name: "Win Draw Loss Win%", Align: text.AlignRight},
+		{Name: "Drawdown", Align: text.AlignRight},
+		{Name: "Score", Align: text.AlignRight},
+	})
+
+	for _, v := range summaries {
+		score := "-"
+		if v.Score != nil {
+			score = fmt.Sprintf("%.3f", *v.Score)
+		}
+		row := table.Row{
+			v.Strategy,
+			v.Trades,
+			fmt.Sprintf("%.2f", v.ProfitMean*100),
+			fmt.Sprintf("%.3f %s", v.ProfitTotalAbs, v.StakeCurrency),
+			fmt.Sprintf("%.2f", v.ProfitTotal*100),
+			(time.Duration(v.HoldingAvgDuration) * time.Second).String(),
+			fmt.Sprintf("%d %d %d %.1f", v.Wins, v.Draws, v.Losses, v.WinRate*100),
+			fmt.Sprintf("%.3f %s %.2f%%", v.DrawdownAbs, v.StakeCurrency, v.DrawdownAbsAccount*100),
+			score,
+		}
+		if crossCheck {
+			check := "-"
+			if v.CrossCheck != nil {
+				check = "ok"
+				if v.CrossCheck.Mismatch() {
+					check = text.Colors{text.FgRed}.Sprint(v.CrossCheck.Describe())
+				}
+			}
+			row = append(row, check)
+		}
+		tSummary.AppendRow(row)
+	}
+
+	r.Render(tSummary, "summary")
+}
@@ -0,0 +1,63 @@
+package render
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+)
+
+// WriteTrades writes the trade records to w as csv, tsv or json
+func WriteTrades(w io.Writer, records []report.TradeRecord, format string) error {
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+	case "csv", "tsv":
+		writer := csv.NewWriter(w)
+		if format == "tsv" {
+			writer.Comma = '\t'
+		}
+
+		err := writer.Write(report.TradeRecordColumns)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			values := record.Values()
+			row := make([]string, 0, len(values))
+			for _, v := range values {
+				row = append(row, formatValue(v))
+			}
+			err = writer.Write(row)
+			if err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+
+		return writer.Error()
+	}
+
+	return fmt.Errorf("unknown trade format %q, expecting csv|tsv|json", format)
+}
+
+// formatValue formats an exported value, dates use RFC 3339 and floats keep their full precision
+func formatValue(v interface{}) string {
+	switch value := v.(type) {
+	case time.Time:
+		if value.IsZero() {
+			return ""
+		}
+		return value.Format(time.RFC3339)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,85 @@
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+)
+
+func TestWriteTrades(t *testing.T) {
+	records := []report.TradeRecord{
+		{
+			Strategy:         "SampleStrategy",
+			Pair:             "BTC/USDT",
+			EnterTag:         "long, breakout",
+			ExitReason:       "roi",
+			ExitReasonDetail: "roi 0",
+			OpenDate:         time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+			CloseDate:        time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC),
+			DurationMinutes:  150,
+			Leverage:         1,
+			OpenRate:         42000.5,
+			CloseRate:        42420.505,
+			StakeAmount:      100,
+			ProfitAbs:        0.8,
+			ProfitRatio:      0.008,
+			MAE:              -0.0125,
+			MFE:              0.01,
+		},
+		{
+			Strategy:         "SampleStrategy",
+			Pair:             "ETH/USDT",
+			EnterTag:         "OTHER",
+			ExitReason:       "force_exit",
+			ExitReasonDetail: "force_exit",
+			IsShort:          true,
+			IsOpen:           true,
+			OpenDate:         time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			Leverage:         3,
+			OpenRate:         2200,
+			StakeAmount:      50,
+		},
+	}
+
+	header := strings.Join(report.TradeRecordColumns, ",")
+	testCases := []struct {
+		format   string
+		expected string
+	}{
+		{
+			format: "csv",
+			expected: header + "\n" +
+				`SampleStrategy,BTC/USDT,"long, breakout",roi,roi 0,false,false,2024-01-01T10:00:00Z,2024-01-01T12:30:00Z,150,1,42000.5,42420.505,100,0.8,0.008,-0.0125,0.01,0` + "\n" +
+				"SampleStrategy,ETH/USDT,OTHER,force_exit,force_exit,true,true,2024-01-02T00:00:00Z,,0,3,2200,0,50,0,0,0,0,0\n",
+		},
+		{
+			format: "tsv",
+			expected: strings.Join(report.TradeRecordColumns, "\t") + "\n" +
+				"SampleStrategy\tBTC/USDT\tlong, breakout\troi\troi 0\tfalse\tfalse\t2024-01-01T10:00:00Z\t2024-01-01T12:30:00Z\t150\t1\t42000.5\t42420.505\t100\t0.8\t0.008\t-0.0125\t0.01\t0\n" +
+				"SampleStrategy\tETH/USDT\tOTHER\tforce_exit\tforce_exit\ttrue\ttrue\t2024-01-02T00:00:00Z\t\t0\t3\t2200\t0\t50\t0\t0\t0\t0\t0\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := WriteTrades(&buf, records, tc.format)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if buf.String() != tc.expected {
+				t.Errorf("expected\n%s\ngot\n%s", tc.expected, buf.String())
+			}
+		})
+	}
+
+	t.Run("unknown format", func(t *testing.T) {
+		err := WriteTrades(&bytes.Buffer{}, records, "xml")
+		if err == nil {
+			t.Errorf("expected an error for an unknown format")
+		}
+	})
+}
@@ -0,0 +1,232 @@
+package report
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/internal/jsonfloat"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/score"
+	"gonum.org/v1/gonum/stat"
+)
+
+// BootstrapOptions holds the bootstrap parameters
+type BootstrapOptions struct {
+	Samples    int
+	Confidence float64
+	Seed       uint64
+}
+
+// bootstrapMetric represents a metric estimated by bootstrap
+type bootstrapMetric struct {
+	name  string
+	value func(s backtest.Strategy, m score.Model) float64
+}
+
+// bootstrapMetrics are the metrics given confidence intervals
+var bootstrapMetrics = []bootstrapMetric{
+	{name: "Sharpe", value: func(s backtest.Strategy, m score.Model) float64 { return s.Sharpe }},
+	{name: "Sortino", value: func(s backtest.Strategy, m score.Model) float64 { return s.Sortino }},
+	{name: "Expectancy", value: func(s backtest.Strategy, m score.Model) float64 { return s.Expectancy }},
+	{name: "Score", value: func(s backtest.Strategy, m score.Model) float64 { return m.Score(s) }},
+}
+
+// WithTrades returns a copy of the Strategy with its trade based metrics recomputed from trades,
+// using freqtrade formulas. Ratios freqtrade sets to -100 when undefined (e.g. no losing trade for sortino)
+// are set to NaN instead.
+func WithTrades(s backtest.Strategy, trades []backtest.Trade) backtest.Strategy {
+	r := s
+	r.Trades = trades
+	r.TotalTrades = len(trades)
+	r.Wins, r.Draws, r.Losses = 0, 0, 0
+
+	profits := make([]float64, len(trades))
+	ratios := make([]float64, len(trades))
+	var winningProfit, losingProfit float64
+	for i, t := range trades {
+		profits[i] = t.ProfitAbs
+		ratios[i] = t.ProfitRatio
+		switch {
+		case t.ProfitAbs > 0:
+			r.Wins++
+			winningProfit = winningProfit + t.ProfitAbs
+		case t.ProfitAbs < 0:
+			r.Losses++
+			losingProfit = losingProfit + t.ProfitAbs
+		default:
+			r.Draws++
+		}
+	}
+
+	r.ProfitTotalAbs = winningProfit + losingProfit
+	r.ProfitTotal = r.ProfitTotalAbs / s.StartingBalance
+	r.FinalBalance = s.StartingBalance + r.ProfitTotalAbs
+	r.ProfitMean = 0
+	if len(ratios) > 0 {
+		r.ProfitMean = stat.Mean(ratios, nil)
+	}
+
+	r.ProfitFactor = 0
+	if losingProfit != 0 {
+		r.ProfitFactor = winningProfit / math.Abs(losingProfit)
+	}
+
+	r.Expectancy = 0
+	if len(trades) > 0 {
+		var averageWin, averageLoss float64
+		if r.Wins > 0 {
+			averageWin = winningProfit / float64(r.Wins)
+		}
+		if r.Losses > 0 {
+			averageLoss = math.Abs(losingProfit) / float64(r.Losses)
+		}
+		r.Expectancy = float64(r.Wins)/float64(len(trades))*averageWin - float64(r.Losses)/float64(len(trades))*averageLoss
+	}
+
+	outcome := sequenceOutcome(s.StartingBalance, profits)
+	r.DrawdownAbs = outcome.MaxDrawdownAbs
+	r.DrawdownRelative = outcome.MaxDrawdownRelative
+	r.DrawdownAbsAccount = outcome.MaxDrawdownRelative
+
+	days := float64(max(1, s.BacktestDays))
+	r.CAGR = math.Pow(r.FinalBalance/s.StartingBalance, 1/(days/365)) - 1
+
+	// returns are relative to the starting balance, averaged over the backtest days
+	returns := make([]float64, len(profits))
+	var downsideReturns []float64
+	for i, p := range profits {
+		returns[i] = p / s.StartingBalance
+		if p < 0 {
+			downsideReturns = append(downsideReturns, returns[i])
+		}
+	}
+	expectedReturnsMean := r.ProfitTotal / days
+
+	r.Sharpe = ratioOrNaN(expectedReturnsMean, populationStdDev(returns))
+	r.Sortino = ratioOrNaN(expectedReturnsMean, populationStdDev(downsideReturns))
+	r.Calmar = ratioOrNaN(expectedReturnsMean*100, r.DrawdownAbsAccount)
+
+	return r
+}
+
+// ratioOrNaN returns the annualized ratio of mean to deviation, NaN when deviation is 0 or NaN
+func ratioOrNaN(mean, deviation float64) float64 {
+	if deviation == 0 || math.IsNaN(deviation) {
+		return math.NaN()
+	}
+
+	return mean / deviation * math.Sqrt(365)
+}
+
+// populationStdDev returns the population standard deviation of values, like numpy std, NaN when empty
+func populationStdDev(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+
+	return stat.PopStdDev(values, nil)
+}
+
+// bootstrapSamples resamples the closed trades of the Strategy with replacement and returns,
+// for each bootstrap metric, its value in every sample.
+func bootstrapSamples(s backtest.Strategy, opts BootstrapOptions, m score.Model) [][]float64 {
+	trades := s.ClosedTrades()
+
+	samples := make([][]float64, len(bootstrapMetrics))
+	for i := range samples {
+		samples[i] = make([]float64, opts.Samples)
+	}
+	if len(trades) == 0 {
+		for i := range samples {
+			for j := range samples[i] {
+				samples[i][j] = math.NaN()
+			}
+		}
+		return samples
+	}
+
+	runSimulations(opts.Samples, func(i int) {
+		rng := simulationRand(opts.Seed, i)
+		sample := make([]backtest.Trade, len(trades))
+		for j, k := range resample(rng, len(trades), ResampleBootstrap) {
+			sample[j] = trades[k]
+		}
+
+		r := WithTrades(s, sample)
+		for j, metric := range bootstrapMetrics {
+			samples[j][i] = metric.value(r, m)
+		}
+	})
+
+	return samples
+}
+
+// Bootstrap returns bootstrap confidence intervals of the Sharpe, Sortino, expectancy and score of the Strategy,
+// recomputed from resampled trades and scored with m. Samples where a metric is undefined are left out of its interval.
+func Bootstrap(s backtest.Strategy, opts BootstrapOptions, m score.Model) BootstrapReport {
+	report := BootstrapReport{
+		Samples:    opts.Samples,
+		Confidence: opts.Confidence,
+		Seed:       opts.Seed,
+	}
+
+	recomputed := WithTrades(s, s.ClosedTrades())
+	for i, values := range bootstrapSamples(s, opts, m) {
+		metric := bootstrapMetrics[i]
+		interval := BootstrapInterval{
+			Metric:     metric.name,
+			Actual:     metric.value(s, m),
+			Recomputed: metric.value(recomputed, m),
+		}
+
+		values = finiteValues(values)
+		interval.Samples = len(values)
+		if len(values) > 0 {
+			interval.Mean = stat.Mean(values, nil)
+			interval.Low = quantile(values, (1-opts.Confidence)/2)
+			interval.Median = quantile(values, 0.5)
+			interval.High = quantile(values, 1-(1-opts.Confidence)/2)
+		} else {
+			interval.Mean, interval.Low, interval.Median, interval.High = math.NaN(), math.NaN(), math.NaN(), math.NaN()
+		}
+
+		report.Intervals = append(report.Intervals, interval)
+	}
+
+	return report
+}
+
+// finiteValues returns the values which are neither NaN nor infinite
+func finiteValues(values []float64) []float64 {
+	var result []float64
+	for _, v := range values {
+		if !math.IsNaN(v) && !math.IsInf(v, 0) {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// MarshalJSON encodes NaN and infinite values as null
+func (bi BootstrapInterval) MarshalJSON() ([]byte, error) {
+	type interval BootstrapInterval
+
+	return json.Marshal(struct {
+		interval
+		Actual     *float64 `json:"actual"`
+		Recomputed *float64 `json:"recomputed"`
+		Mean       *float64 `json:"mean"`
+		Low        *float64 `json:"low"`
+		Median     *float64 `json:"median"`
+		High       *float64 `json:"high"`
+	}{
+		interval:   interval(bi),
+		Actual:     jsonfloat.Finite(bi.Actual),
+		Recomputed: jsonfloat.Finite(bi.Recomputed),
+		Mean:       jsonfloat.Finite(bi.Mean),
+		Low:        jsonfloat.Finite(bi.Low),
+		Median:     jsonfloat.Finite(bi.Median),
+		High:       jsonfloat.Finite(bi.High),
+	})
+}
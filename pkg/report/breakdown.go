@@ -0,0 +1,118 @@
+package report
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// breakdownPeriods are the supported breakdown periods
+var breakdownPeriods = []string{"day", "week", "month", "year"}
+
+// ParseBreakdownPeriods parses a comma separated list of breakdown periods
+func ParseBreakdownPeriods(value string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var periods []string
+	for _, p := range strings.Split(value, ",") {
+		p = strings.TrimSpace(p)
+		if !slices.Contains(breakdownPeriods, p) {
+			return nil, fmt.Errorf("unknown breakdown period %q, expecting one of %s", p, strings.Join(breakdownPeriods, "|"))
+		}
+		periods = append(periods, p)
+	}
+
+	return periods, nil
+}
+
+// periodEnd returns the last day of the period containing t, which labels the period like freqtrade does.
+// Periods follow pandas resampling, weeks run from tuesday to monday (W-MON) and months and years end on their last day.
+func periodEnd(t time.Time, period string) time.Time {
+	y, m, d := t.Date()
+	switch period {
+	case "week":
+		offset := (8 - int(t.Weekday())) % 7
+		return time.Date(y, m, d+offset, 0, 0, 0, 0, t.Location())
+	case "month":
+		return time.Date(y, m+1, 0, 0, 0, 0, 0, t.Location())
+	case "year":
+		return time.Date(y, 12, 31, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// periodStart returns the first day of the period ending at end
+func periodStart(end time.Time, period string) time.Time {
+	y, m, d := end.Date()
+	switch period {
+	case "week":
+		return time.Date(y, m, d-6, 0, 0, 0, 0, end.Location())
+	case "month":
+		return time.Date(y, m, 1, 0, 0, 0, 0, end.Location())
+	case "year":
+		return time.Date(y, 1, 1, 0, 0, 0, 0, end.Location())
+	default:
+		return end
+	}
+}
+
+// StrategyBreakdownReport returns a BreakdownReport for the Strategy,
+// closed trades are bucketed by close date using the given period, periods are named after their last day.
+func StrategyBreakdownReport(s backtest.Strategy, period string) BreakdownReport {
+	report := BreakdownReport{
+		Period: period,
+	}
+
+	index := make(map[time.Time]int)
+	for _, t := range s.Trades {
+		if t.IsOpen || t.CloseDate.IsZero() {
+			continue
+		}
+
+		end := periodEnd(t.CloseDate.Time, period)
+		i, ok := index[end]
+		if !ok {
+			report.Periods = append(report.Periods, PeriodReport{
+				Name:  end.Format(time.DateOnly),
+				Start: periodStart(end, period),
+			})
+			i = len(report.Periods) - 1
+			index[end] = i
+		}
+		pr := &report.Periods[i]
+
+		pr.Trades++
+		pr.ProfitAbs = pr.ProfitAbs + t.ProfitAbs
+		switch {
+		case t.ProfitAbs > 0:
+			pr.Wins++
+		case t.ProfitAbs < 0:
+			pr.Losses++
+		default:
+			pr.Draws++
+		}
+	}
+
+	sort.Slice(report.Periods, func(i, j int) bool {
+		return report.Periods[i].Start.Before(report.Periods[j].Start)
+	})
+
+	balance := s.StartingBalance
+	for i := range report.Periods {
+		pr := &report.Periods[i]
+		balance = balance + pr.ProfitAbs
+		pr.Balance = balance
+		if prev := balance - pr.ProfitAbs; prev != 0 {
+			pr.Profit = pr.ProfitAbs / prev
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,103 @@
+package report
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+func TestStrategyBreakdownReport(t *testing.T) {
+	closeDates := []time.Time{
+		time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), // monday
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),  // tuesday
+		time.Date(2024, 1, 7, 23, 0, 0, 0, time.UTC), // sunday
+		time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),  // monday
+		time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC),
+	}
+	var trades []backtest.Trade
+	for _, d := range closeDates {
+		trades = append(trades, backtest.Trade{CloseDate: backtest.CustomTime{Time: d}, ProfitAbs: 1})
+	}
+	trades = append(trades, backtest.Trade{IsOpen: true, ProfitAbs: 1})
+
+	testCases := []struct {
+		period string
+		names  []string
+		starts []string
+		trades []int
+	}{
+		{
+			period: "week",
+			names:  []string{"2024-01-01", "2024-01-08", "2024-02-05"},
+			starts: []string{"2023-12-26", "2024-01-02", "2024-01-30"},
+			trades: []int{1, 3, 2},
+		},
+		{
+			period: "month",
+			names:  []string{"2024-01-31", "2024-02-29"},
+			starts: []string{"2024-01-01", "2024-02-01"},
+			trades: []int{5, 1},
+		},
+		{
+			period: "year",
+			names:  []string{"2024-12-31"},
+			starts: []string{"2024-01-01"},
+			trades: []int{6},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.period, func(t *testing.T) {
+			report := StrategyBreakdownReport(backtest.Strategy{StartingBalance: 100, Trades: trades}, tc.period)
+
+			var names, starts []string
+			var counts []int
+			for _, p := range report.Periods {
+				names = append(names, p.Name)
+				starts = append(starts, p.Start.Format(time.DateOnly))
+				counts = append(counts, p.Trades)
+			}
+			if !reflect.DeepEqual(names, tc.names) {
+				t.Errorf("expected periods %v, got %v", tc.names, names)
+			}
+			if !reflect.DeepEqual(starts, tc.starts) {
+				t.Errorf("expected period starts %v, got %v", tc.starts, starts)
+			}
+			if !reflect.DeepEqual(counts, tc.trades) {
+				t.Errorf("expected trades %v, got %v", tc.trades, counts)
+			}
+
+			// periods are named like freqtrade labels them
+			for _, d := range closeDates {
+				label := freqtradePeriod(d, tc.period).Format(time.DateOnly)
+				if !slices.Contains(names, label) {
+					t.Errorf("expected freqtrade period %s of %s to be reported, got %v", label, d, names)
+				}
+			}
+		})
+	}
+}
+
+func TestStrategyBreakdownReportTimezone(t *testing.T) {
+	// monday 23:30 in UTC is tuesday in Paris, the week ends on monday in UTC but not in Paris
+	paris := time.FixedZone("CET", 3600)
+	closeDate := time.Date(2024, 1, 8, 23, 30, 0, 0, time.UTC).In(paris)
+	s := backtest.Strategy{Trades: []backtest.Trade{{CloseDate: backtest.CustomTime{Time: closeDate}}}}
+
+	report := StrategyBreakdownReport(s, "week")
+	if len(report.Periods) != 1 || report.Periods[0].Name != "2024-01-15" {
+		t.Errorf("expected the week ending on 2024-01-15, got %+v", report.Periods)
+	}
+	if label := freqtradePeriod(closeDate, "week").Format(time.DateOnly); label != "2024-01-08" {
+		t.Errorf("expected freqtrade to label the week in UTC, got %s", label)
+	}
+
+	report = StrategyBreakdownReport(s, "day")
+	if len(report.Periods) != 1 || report.Periods[0].Name != "2024-01-09" {
+		t.Errorf("expected the day 2024-01-09 in the trade timezone, got %+v", report.Periods)
+	}
+}
@@ -0,0 +1,236 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/score"
+)
+
+// ComparisonRun represents a single strategy run to compare
+type ComparisonRun struct {
+	Label    string
+	Strategy backtest.Strategy
+}
+
+// Comparison represents strategy runs compared side by side against a baseline run
+type Comparison struct {
+	Labels    []string
+	Baseline  int
+	Metrics   []ComparisonMetric
+	Bootstrap *ComparisonBootstrap
+}
+
+// ComparisonMetric represents the values of a metric across runs, Format is the fmt verb used to display values
+type ComparisonMetric struct {
+	Name           string
+	Format         string
+	HigherIsBetter bool
+	Values         []float64
+}
+
+// ComparisonBootstrap represents bootstrap confidence intervals of metrics across runs
+type ComparisonBootstrap struct {
+	Samples    int
+	Confidence float64
+	Seed       uint64
+	Metrics    []ComparisonInterval
+}
+
+// ComparisonInterval represents the confidence interval of a metric for each run,
+// along with the probability of each run beating the baseline. Undefined values are NaN.
+type ComparisonInterval struct {
+	Name         string
+	Low          []float64
+	High         []float64
+	BeatBaseline []float64
+}
+
+// comparisonMetric represents a metric row of the comparison table
+type comparisonMetric struct {
+	name           string
+	higherIsBetter bool
+	value          func(s backtest.Strategy, m score.Model) float64
+	format         string
+}
+
+// comparisonMetrics are the metrics displayed in the comparison table
+var comparisonMetrics = []comparisonMetric{
+	{name: "Trades", higherIsBetter: true, value: func(s backtest.Strategy, m score.Model) float64 { return float64(s.TotalTrades) }, format: "%.0f"},
+	{name: "Absolute profit", higherIsBetter: true, value: func(s backtest.Strategy, m score.Model) float64 { return s.ProfitTotalAbs }, format: "%.3f"},
+	{name: "Total profit %", higherIsBetter: true, value: func(s backtest.Strategy, m score.Model) float64 { return s.ProfitTotal * 100 }, format: "%.2f"},
+	{name: "Avg profit %", higherIsBetter: true, value: func(s backtest.Strategy, m score.Model) float64 { return s.ProfitMean * 100 }, format: "%.2f"},
+	{name: "CAGR %", higherIsBetter: true, value: func(s backtest.Strategy, m score.Model) float64 { return s.CAGR * 100 }, format: "%.2f"},
+	{name: "Sharpe", higherIsBetter: true, value: func(s backtest.Strategy, m score.Model) float64 { return s.Sharpe }, format: "%.2f"},
+	{name: "Sortino", higherIsBetter: true, value: func(s backtest.Strategy, m score.Model) float64 { return s.Sortino }, format: "%.2f"},
+	{name: "Calmar", higherIsBetter: true, value: func(s backtest.Strategy, m score.Model) float64 { return s.Calmar }, format: "%.2f"},
+	{name: "Profit factor", higherIsBetter: true, value: func(s backtest.Strategy, m score.Model) float64 { return s.ProfitFactor }, format: "%.2f"},
+	{name: "Expectancy", higherIsBetter: true, value: func(s backtest.Strategy, m score.Model) float64 { return s.Expectancy }, format: "%.2f"},
+	{name: "Win %", higherIsBetter: true, value: func(s backtest.Strategy, m score.Model) float64 { return s.WinRate() * 100 }, format: "%.2f"},
+	{name: "Max drawdown %", higherIsBetter: false, value: func(s backtest.Strategy, m score.Model) float64 { return s.DrawdownRelative * 100 }, format: "%.2f"},
+	{name: "Max drawdown", higherIsBetter: false, value: func(s backtest.Strategy, m score.Model) float64 { return s.DrawdownAbs }, format: "%.3f"},
+	{name: "Score", higherIsBetter: true, value: func(s backtest.Strategy, m score.Model) float64 { return m.Score(s) }, format: "%.3f"},
+}
+
+// ComparisonRuns returns a ComparisonRun for every strategy of the given results.
+// Runs are labelled with the strategy name, and the result name when needed to tell them apart.
+func ComparisonRuns(results []*backtest.BacktestResult) []ComparisonRun {
+	count := make(map[string]int)
+	for _, br := range results {
+		for name := range br.Strategy {
+			count[name]++
+		}
+	}
+
+	var runs []ComparisonRun
+	for _, br := range results {
+		for _, name := range br.StrategyNames() {
+			label := name
+			if count[name] > 1 {
+				label = fmt.Sprintf("%s (%s)", name, br.Name())
+			}
+			runs = append(runs, ComparisonRun{
+				Label:    label,
+				Strategy: br.Strategy[name],
+			})
+		}
+	}
+
+	return runs
+}
+
+// baselineIndex returns the index of the baseline run,
+// baseline is either a run label or its 1-based position.
+func baselineIndex(runs []ComparisonRun, baseline string) (int, error) {
+	for i, run := range runs {
+		if run.Label == baseline {
+			return i, nil
+		}
+	}
+
+	i, err := strconv.Atoi(baseline)
+	if err != nil || i < 1 || i > len(runs) {
+		return 0, fmt.Errorf("unknown baseline %q, expecting a run name or a position between 1 and %d", baseline, len(runs))
+	}
+
+	return i - 1, nil
+}
+
+// Compare compares the runs against the baseline run, baseline is either a run label or its 1-based position.
+// Bootstrap confidence intervals are computed when opts.Bootstrap.Samples is greater than 0.
+func Compare(runs []ComparisonRun, baseline string, opts Options) (Comparison, error) {
+	base, err := baselineIndex(runs, baseline)
+	if err != nil {
+		return Comparison{}, err
+	}
+
+	comparison := Comparison{
+		Baseline: base,
+	}
+	for _, run := range runs {
+		comparison.Labels = append(comparison.Labels, run.Label)
+	}
+
+	model := opts.scoreModel()
+	for _, m := range comparisonMetrics {
+		metric := ComparisonMetric{
+			Name:           m.name,
+			Format:         m.format,
+			HigherIsBetter: m.higherIsBetter,
+		}
+		for _, run := range runs {
+			metric.Values = append(metric.Values, m.value(run.Strategy, model))
+		}
+		comparison.Metrics = append(comparison.Metrics, metric)
+	}
+
+	if opts.Bootstrap.Samples > 0 {
+		bootstrap := compareBootstrap(runs, base, opts.Bootstrap, model)
+		comparison.Bootstrap = &bootstrap
+	}
+
+	return comparison, nil
+}
+
+// Best returns the best value of the metric across runs, NaN values are ignored
+func (cm ComparisonMetric) Best() float64 {
+	best := math.NaN()
+	for _, v := range cm.Values {
+		if math.IsNaN(v) {
+			continue
+		}
+		if math.IsNaN(best) || (cm.HigherIsBetter && v > best) || (!cm.HigherIsBetter && v < best) {
+			best = v
+		}
+	}
+
+	return best
+}
+
+// beatProbability returns the probability of samples beating the paired baseline samples, ties count
+// as half a win so that even runs get 50%, e.g. when both score the fail score. Pairs with a NaN are left out.
+func beatProbability(samples, baseline []float64) float64 {
+	var wins float64
+	pairs := 0
+	for j, v := range samples {
+		b := baseline[j]
+		if math.IsNaN(v) || math.IsNaN(b) {
+			continue
+		}
+		pairs++
+		switch {
+		case v > b:
+			wins++
+		case v == b:
+			wins += 0.5
+		}
+	}
+	if pairs == 0 {
+		return math.NaN()
+	}
+
+	return wins / float64(pairs)
+}
+
+// compareBootstrap computes the bootstrap confidence interval of each run and
+// the probability of each run beating the baseline, from pairing the samples of both runs.
+func compareBootstrap(runs []ComparisonRun, base int, opts BootstrapOptions, model score.Model) ComparisonBootstrap {
+	samples := make([][][]float64, len(runs))
+	for i, run := range runs {
+		// every run gets its own random stream
+		runOpts := opts
+		runOpts.Seed = opts.Seed + uint64(i)
+		samples[i] = bootstrapSamples(run.Strategy, runOpts, model)
+	}
+
+	bootstrap := ComparisonBootstrap{
+		Samples:    opts.Samples,
+		Confidence: opts.Confidence,
+		Seed:       opts.Seed,
+	}
+	for m, metric := range bootstrapMetrics {
+		interval := ComparisonInterval{
+			Name: metric.name,
+		}
+		for i := range runs {
+			low, high := math.NaN(), math.NaN()
+			if values := finiteValues(samples[i][m]); len(values) > 0 {
+				low = quantile(values, (1-opts.Confidence)/2)
+				high = quantile(values, 1-(1-opts.Confidence)/2)
+			}
+			interval.Low = append(interval.Low, low)
+			interval.High = append(interval.High, high)
+
+			probability := math.NaN()
+			if i != base {
+				probability = beatProbability(samples[i][m], samples[base][m])
+			}
+			interval.BeatBaseline = append(interval.BeatBaseline, probability)
+		}
+		bootstrap.Metrics = append(bootstrap.Metrics, interval)
+	}
+
+	return bootstrap
+}
@@ -0,0 +1,63 @@
+package report
+
+import (
+	"math"
+	"testing"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/score"
+)
+
+func TestBeatProbability(t *testing.T) {
+	nan := math.NaN()
+	testCases := []struct {
+		name     string
+		samples  []float64
+		baseline []float64
+		expected float64
+	}{
+		{name: "always better", samples: []float64{2, 3}, baseline: []float64{1, 1}, expected: 1},
+		{name: "always worse", samples: []float64{0, 0}, baseline: []float64{1, 1}, expected: 0},
+		{name: "ties count as half a win", samples: []float64{-1, -1, -1, -1}, baseline: []float64{-1, -1, -1, -1}, expected: 0.5},
+		{name: "mixed", samples: []float64{2, -1, 0, -1}, baseline: []float64{1, -1, 1, 3}, expected: 1.5 / 4},
+		{name: "NaN pairs are left out", samples: []float64{nan, 2, 1}, baseline: []float64{1, nan, 0}, expected: 1},
+		{name: "no pair", samples: []float64{nan}, baseline: []float64{1}, expected: nan},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := beatProbability(tc.samples, tc.baseline)
+			if math.IsNaN(tc.expected) {
+				if !math.IsNaN(p) {
+					t.Errorf("expected NaN, got %v", p)
+				}
+				return
+			}
+			if math.Abs(p-tc.expected) > 1e-12 {
+				t.Errorf("expected %v, got %v", tc.expected, p)
+			}
+		})
+	}
+}
+
+func TestCompareBootstrapItself(t *testing.T) {
+	// break-even trades, so that many samples hit the fail score
+	var trades []backtest.Trade
+	for i := 0; i < 40; i++ {
+		profit := 1.0
+		if i%2 == 1 {
+			profit = -1.05
+		}
+		trades = append(trades, backtest.Trade{ProfitAbs: profit, ProfitRatio: profit / 100, StakeAmount: 100})
+	}
+	s := backtest.Strategy{StartingBalance: 1000, BacktestDays: 30, Trades: trades}
+	runs := []ComparisonRun{{Label: "a", Strategy: s}, {Label: "b", Strategy: s}}
+
+	bootstrap := compareBootstrap(runs, 0, BootstrapOptions{Samples: 4000, Confidence: 0.95, Seed: 42}, score.Default)
+	for _, m := range bootstrap.Metrics {
+		p := m.BeatBaseline[1]
+		if math.Abs(p-0.5) > 0.05 {
+			t.Errorf("%s: expected a run compared with itself to beat the baseline about 50%% of the time, got %.1f%%", m.Name, p*100)
+		}
+	}
+}
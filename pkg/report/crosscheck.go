@@ -0,0 +1,381 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// crossCheckTolerance is the difference allowed between freqtrade values and the recomputed ones,
+// relative to the largest of both values or absolute below 1.
+const crossCheckTolerance = 1e-6
+
+// crossCheckTotal is the key of the last row of freqtrade results tables
+const crossCheckTotal = "TOTAL"
+
+// Cross checked fields
+const (
+	fieldTrades         = "trades"
+	fieldProfitMean     = "profit_mean"
+	fieldProfitTotalAbs = "profit_total_abs"
+	fieldWins           = "wins"
+	fieldDraws          = "draws"
+	fieldLosses         = "losses"
+)
+
+// resultsFields are the fields checked for freqtrade results tables
+var resultsFields = []string{fieldTrades, fieldProfitMean, fieldProfitTotalAbs, fieldWins, fieldDraws, fieldLosses}
+
+// CrossCheck represents the results tables of a strategy as reported by freqtrade,
+// checked against the values recomputed from its trades.
+type CrossCheck struct {
+	Sections   []CrossCheckSection `json:"sections"`
+	Mismatches int                 `json:"mismatches"`
+}
+
+// CrossCheckSection represents a freqtrade results table, e.g. results_per_pair
+type CrossCheckSection struct {
+	Name string `json:"name"`
+	// Fields are the checked fields
+	Fields []string `json:"fields"`
+	// Periodic sections have a row per period
+	Periodic   bool            `json:"periodic"`
+	Rows       []CrossCheckRow `json:"rows"`
+	Mismatches int             `json:"mismatches"`
+}
+
+// CrossCheckRow represents a row of a freqtrade results table,
+// Reported is nil when the row is missing from freqtrade.
+type CrossCheckRow struct {
+	Key        string            `json:"key"`
+	Reported   *CrossCheckValues `json:"reported"`
+	Recomputed *CrossCheckValues `json:"recomputed"`
+	// Mismatches are the checked fields which differ
+	Mismatches []string `json:"mismatches,omitempty"`
+}
+
+// CrossCheckValues are the values of a row of a freqtrade results table
+type CrossCheckValues struct {
+	Trades         int     `json:"trades"`
+	ProfitMean     float64 `json:"profit_mean"`
+	ProfitTotalAbs float64 `json:"profit_total_abs"`
+	Wins           int     `json:"wins"`
+	Draws          int     `json:"draws"`
+	Losses         int     `json:"losses"`
+
+	profitSum float64
+}
+
+// Mismatch returns whether the row differs between freqtrade and the recomputed values
+func (r CrossCheckRow) Mismatch() bool {
+	return len(r.Mismatches) > 0 || r.Reported == nil
+}
+
+// Describe returns a description of the differences of the row
+func (r CrossCheckRow) Describe() string {
+	if r.Reported == nil {
+		return "missing from freqtrade"
+	}
+
+	var diffs []string
+	for _, f := range r.Mismatches {
+		diffs = append(diffs, fmt.Sprintf("%s %s vs %s", f, formatField(r.Reported.field(f)), formatField(r.Recomputed.field(f))))
+	}
+
+	return strings.Join(diffs, ", ")
+}
+
+// Warnings returns a warning for every row differing between freqtrade and the recomputed values
+func (c CrossCheck) Warnings() []string {
+	var warnings []string
+	for _, section := range c.Sections {
+		for _, row := range section.Rows {
+			if row.Mismatch() {
+				warnings = append(warnings, fmt.Sprintf("%s %s differs from recomputed trades: %s", section.Name, row.Key, row.Describe()))
+			}
+		}
+	}
+
+	return warnings
+}
+
+// field returns the value of the named field
+func (v CrossCheckValues) field(name string) float64 {
+	switch name {
+	case fieldTrades:
+		return float64(v.Trades)
+	case fieldProfitMean:
+		return v.ProfitMean
+	case fieldProfitTotalAbs:
+		return v.ProfitTotalAbs
+	case fieldWins:
+		return float64(v.Wins)
+	case fieldDraws:
+		return float64(v.Draws)
+	case fieldLosses:
+		return float64(v.Losses)
+	}
+
+	return math.NaN()
+}
+
+// addTrade adds a trade to the values, trades are counted like freqtrade does, by absolute profit
+func (v *CrossCheckValues) addTrade(t backtest.Trade) {
+	v.Trades++
+	v.ProfitTotalAbs += t.ProfitAbs
+	v.profitSum += t.ProfitRatio
+	v.ProfitMean = v.profitSum / float64(v.Trades)
+	switch {
+	case t.ProfitAbs > 0:
+		v.Wins++
+	case t.ProfitAbs < 0:
+		v.Losses++
+	default:
+		v.Draws++
+	}
+}
+
+// compare returns the fields which differ between a and b
+func compare(a, b CrossCheckValues, fields []string) []string {
+	var mismatches []string
+	for _, f := range fields {
+		x, y := a.field(f), b.field(f)
+		if math.Abs(x-y) > crossCheckTolerance*math.Max(1, math.Max(math.Abs(x), math.Abs(y))) {
+			mismatches = append(mismatches, f)
+		}
+	}
+
+	return mismatches
+}
+
+// resultsValues returns the values of a freqtrade results row
+func resultsValues(r backtest.ResultsRow) CrossCheckValues {
+	return CrossCheckValues{
+		Trades:         r.Trades,
+		ProfitMean:     r.ProfitMean,
+		ProfitTotalAbs: r.ProfitTotalAbs,
+		Wins:           r.Wins,
+		Draws:          r.Draws,
+		Losses:         r.Losses,
+	}
+}
+
+// recompute groups the trades by key, the TOTAL key holds every trade
+func recompute(trades []backtest.Trade, key func(backtest.Trade) string) map[string]*CrossCheckValues {
+	values := map[string]*CrossCheckValues{crossCheckTotal: {}}
+	for _, t := range trades {
+		k := key(t)
+		if values[k] == nil {
+			values[k] = &CrossCheckValues{}
+		}
+		values[k].addTrade(t)
+		if k != crossCheckTotal {
+			values[crossCheckTotal].addTrade(t)
+		}
+	}
+
+	return values
+}
+
+// newSection checks the reported values against the recomputed ones, rows are in freqtrade order
+// followed by recomputed rows missing from freqtrade.
+func newSection(name string, fields []string, periodic bool, reported []string, reportedValues map[string]CrossCheckValues, recomputed map[string]*CrossCheckValues) CrossCheckSection {
+	section := CrossCheckSection{
+		Name:     name,
+		Fields:   fields,
+		Periodic: periodic,
+	}
+
+	seen := make(map[string]bool)
+	for _, key := range reported {
+		seen[key] = true
+		v := reportedValues[key]
+		row := CrossCheckRow{
+			Key:        key,
+			Reported:   &v,
+			Recomputed: recomputed[key],
+		}
+		if row.Recomputed == nil {
+			// freqtrade lists pairs and periods without trades
+			row.Recomputed = &CrossCheckValues{}
+		}
+		row.Mismatches = compare(*row.Reported, *row.Recomputed, fields)
+		section.Rows = append(section.Rows, row)
+	}
+
+	var missing []string
+	for key := range recomputed {
+		if !seen[key] {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(missing)
+	for _, key := range missing {
+		section.Rows = append(section.Rows, CrossCheckRow{
+			Key:        key,
+			Recomputed: recomputed[key],
+		})
+	}
+
+	for _, row := range section.Rows {
+		if row.Mismatch() {
+			section.Mismatches++
+		}
+	}
+
+	return section
+}
+
+// resultsSection checks a freqtrade results table, trades are grouped by key
+func resultsSection(name string, rows []backtest.ResultsRow, trades []backtest.Trade, key func(backtest.Trade) string) CrossCheckSection {
+	var keys []string
+	values := make(map[string]CrossCheckValues)
+	for _, r := range rows {
+		keys = append(keys, r.Key)
+		values[r.Key] = resultsValues(r)
+	}
+
+	return newSection(name, resultsFields, false, keys, values, recompute(trades, key))
+}
+
+// freqtradePeriod returns the label freqtrade gives to the period containing t,
+// freqtrade buckets trades in UTC.
+func freqtradePeriod(t time.Time, period string) time.Time {
+	return periodEnd(t.UTC(), period)
+}
+
+// periodicSection checks a freqtrade periodic table, trades are grouped by close date
+func periodicSection(name string, fields []string, periods []time.Time, values map[string]CrossCheckValues, trades []backtest.Trade, period string) CrossCheckSection {
+	var keys []string
+	for _, p := range periods {
+		keys = append(keys, p.UTC().Format(time.DateOnly))
+	}
+
+	recomputed := recompute(trades, func(t backtest.Trade) string {
+		return freqtradePeriod(t.CloseDate.Time, period).Format(time.DateOnly)
+	})
+	delete(recomputed, crossCheckTotal)
+
+	return newSection(name, fields, true, keys, values, recomputed)
+}
+
+// StrategyCrossCheck checks the results tables freqtrade wrote for the Strategy against the values
+// recomputed from its trades, it returns nil when the result has none of these tables.
+func StrategyCrossCheck(s backtest.Strategy) *CrossCheck {
+	var trades []backtest.Trade
+	var openTrades []backtest.Trade
+	for _, t := range s.Trades {
+		if t.CloseDate.IsZero() {
+			continue
+		}
+		trades = append(trades, t)
+		if t.IsOpen {
+			openTrades = append(openTrades, t)
+		}
+	}
+
+	check := &CrossCheck{}
+	if len(s.ResultsPerPair) > 0 {
+		check.Sections = append(check.Sections, resultsSection("results_per_pair", s.ResultsPerPair, trades, func(t backtest.Trade) string {
+			return t.Pair
+		}))
+	}
+	if len(s.ResultsPerEnterTag) > 0 {
+		check.Sections = append(check.Sections, resultsSection("results_per_enter_tag", s.ResultsPerEnterTag, trades, backtest.Trade.GetEnterTag))
+	}
+	if len(s.ExitReasonSummary) > 0 {
+		check.Sections = append(check.Sections, resultsSection("exit_reason_summary", s.ExitReasonSummary, trades, func(t backtest.Trade) string {
+			return t.ExitReason
+		}))
+	}
+	if len(s.LeftOpenTrades) > 0 {
+		check.Sections = append(check.Sections, resultsSection("left_open_trades", s.LeftOpenTrades, openTrades, func(t backtest.Trade) string {
+			return t.Pair
+		}))
+	}
+
+	for _, period := range breakdownPeriods {
+		results, ok := s.PeriodicBreakdown[period]
+		if !ok || len(results) == 0 {
+			continue
+		}
+
+		var dates []time.Time
+		values := make(map[string]CrossCheckValues)
+		for _, r := range results {
+			dates = append(dates, r.Date.Time)
+			values[r.Date.UTC().Format(time.DateOnly)] = CrossCheckValues{
+				Trades:         r.Trades,
+				ProfitTotalAbs: r.ProfitAbs,
+				Wins:           r.Wins,
+				Draws:          r.Draws,
+				Losses:         r.Losses,
+			}
+		}
+		fields := []string{fieldTrades, fieldProfitTotalAbs, fieldWins, fieldDraws, fieldLosses}
+		check.Sections = append(check.Sections, periodicSection("periodic_breakdown "+period, fields, dates, values, trades, period))
+	}
+
+	if len(s.DailyProfit) > 0 {
+		var dates []time.Time
+		values := make(map[string]CrossCheckValues)
+		for _, d := range s.DailyProfit {
+			dates = append(dates, d.Date.Time)
+			values[d.Date.UTC().Format(time.DateOnly)] = CrossCheckValues{ProfitTotalAbs: d.ProfitAbs}
+		}
+		check.Sections = append(check.Sections, periodicSection("daily_profit", []string{fieldProfitTotalAbs}, dates, values, trades, "day"))
+	}
+
+	if len(check.Sections) == 0 {
+		return nil
+	}
+
+	for _, section := range check.Sections {
+		check.Mismatches += section.Mismatches
+	}
+
+	return check
+}
+
+// formatField formats a field value, without decimals for counts
+func formatField(v float64) string {
+	if v == math.Trunc(v) {
+		return fmt.Sprintf("%.0f", v)
+	}
+
+	return fmt.Sprintf("%.6g", v)
+}
+
+// StrategyComparisonCheck checks freqtrade strategy comparison row of the strategy against its trades,
+// it returns nil when the result has no strategy comparison.
+func StrategyComparisonCheck(br *backtest.BacktestResult, name string) *CrossCheckRow {
+	if len(br.StrategyComparison) == 0 {
+		return nil
+	}
+
+	s := br.Strategy[name]
+	recomputed := &CrossCheckValues{}
+	for _, t := range s.Trades {
+		if !t.CloseDate.IsZero() {
+			recomputed.addTrade(t)
+		}
+	}
+
+	row := &CrossCheckRow{
+		Key:        name,
+		Recomputed: recomputed,
+	}
+	for _, r := range br.StrategyComparison {
+		if r.Key == name {
+			v := resultsValues(r)
+			row.Reported = &v
+			row.Mismatches = compare(v, *recomputed, resultsFields)
+			break
+		}
+	}
+	return row
+}
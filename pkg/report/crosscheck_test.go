@@ -0,0 +1,163 @@
+package report
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+func TestFreqtradePeriod(t *testing.T) {
+	testCases := []struct {
+		name     string
+		date     time.Time
+		period   string
+		expected string
+	}{
+		{name: "day", date: time.Date(2024, 1, 3, 23, 59, 0, 0, time.UTC), period: "day", expected: "2024-01-03"},
+		{name: "day in another timezone", date: time.Date(2024, 1, 4, 1, 0, 0, 0, time.FixedZone("CET", 3600)), period: "day", expected: "2024-01-04"},
+		{name: "day converted to UTC", date: time.Date(2024, 1, 4, 0, 30, 0, 0, time.FixedZone("CET", 3600)), period: "day", expected: "2024-01-03"},
+		{name: "week on a monday", date: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), period: "week", expected: "2024-01-01"},
+		{name: "week on a tuesday", date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), period: "week", expected: "2024-01-08"},
+		{name: "week on a sunday", date: time.Date(2024, 1, 7, 23, 59, 0, 0, time.UTC), period: "week", expected: "2024-01-08"},
+		{name: "week across the year", date: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), period: "week", expected: "2025-01-06"},
+		{name: "month start", date: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), period: "month", expected: "2024-02-29"},
+		{name: "month end", date: time.Date(2023, 2, 28, 23, 59, 0, 0, time.UTC), period: "month", expected: "2023-02-28"},
+		{name: "december", date: time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC), period: "month", expected: "2024-12-31"},
+		{name: "year", date: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), period: "year", expected: "2024-12-31"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			label := freqtradePeriod(tc.date, tc.period).Format(time.DateOnly)
+			if label != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, label)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        CrossCheckValues
+		b        CrossCheckValues
+		expected []string
+	}{
+		{name: "equal", a: CrossCheckValues{Trades: 3, ProfitTotalAbs: 12.5}, b: CrossCheckValues{Trades: 3, ProfitTotalAbs: 12.5}},
+		{name: "absolute tolerance below 1", a: CrossCheckValues{ProfitMean: 0.0123456}, b: CrossCheckValues{ProfitMean: 0.0123461}},
+		{name: "absolute tolerance exceeded below 1", a: CrossCheckValues{ProfitMean: 0.012345}, b: CrossCheckValues{ProfitMean: 0.012347}, expected: []string{fieldProfitMean}},
+		{name: "relative tolerance", a: CrossCheckValues{ProfitTotalAbs: 123456.7891}, b: CrossCheckValues{ProfitTotalAbs: 123456.85}},
+		{name: "relative tolerance exceeded", a: CrossCheckValues{ProfitTotalAbs: 123456.7891}, b: CrossCheckValues{ProfitTotalAbs: 123457}, expected: []string{fieldProfitTotalAbs}},
+		{name: "rounded freqtrade value", a: CrossCheckValues{ProfitTotalAbs: 12.34567891}, b: CrossCheckValues{ProfitTotalAbs: 12.345679}},
+		{name: "counts", a: CrossCheckValues{Trades: 3, Wins: 2, Losses: 1}, b: CrossCheckValues{Trades: 3, Wins: 1, Draws: 1, Losses: 1}, expected: []string{fieldWins, fieldDraws}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mismatches := compare(tc.a, tc.b, resultsFields)
+			if !reflect.DeepEqual(mismatches, tc.expected) {
+				t.Errorf("expected mismatches %v, got %v", tc.expected, mismatches)
+			}
+		})
+	}
+}
+
+func TestNewSection(t *testing.T) {
+	trades := []backtest.Trade{
+		{Pair: "BTC/USDT", ProfitAbs: 2, ProfitRatio: 0.02},
+		{Pair: "BTC/USDT", ProfitAbs: -1, ProfitRatio: -0.01},
+		{Pair: "SOL/USDT", ProfitAbs: 0, ProfitRatio: 0},
+	}
+	rows := []backtest.ResultsRow{
+		{Key: "BTC/USDT", Trades: 2, ProfitMean: 0.005, ProfitTotalAbs: 1, Wins: 1, Losses: 1},
+		{Key: "ETH/USDT"},
+		{Key: crossCheckTotal, Trades: 3, ProfitMean: 0.01 / 3, ProfitTotalAbs: 1, Wins: 1, Draws: 1, Losses: 1},
+	}
+
+	section := resultsSection("results_per_pair", rows, trades, func(t backtest.Trade) string {
+		return t.Pair
+	})
+
+	var keys []string
+	for _, row := range section.Rows {
+		keys = append(keys, row.Key)
+	}
+	expectedKeys := []string{"BTC/USDT", "ETH/USDT", crossCheckTotal, "SOL/USDT"}
+	if !reflect.DeepEqual(keys, expectedKeys) {
+		t.Fatalf("expected rows %v, got %v", expectedKeys, keys)
+	}
+
+	// ETH/USDT has no trade, it matches zero values
+	for _, row := range section.Rows[:3] {
+		if row.Mismatch() {
+			t.Errorf("expected %s to match, got %s", row.Key, row.Describe())
+		}
+	}
+
+	missing := section.Rows[3]
+	if missing.Reported != nil || !missing.Mismatch() || missing.Describe() != "missing from freqtrade" {
+		t.Errorf("expected SOL/USDT to be missing from freqtrade, got %s", missing.Describe())
+	}
+	if section.Mismatches != 1 {
+		t.Errorf("expected 1 mismatch, got %d", section.Mismatches)
+	}
+}
+
+func TestStrategyCrossCheck(t *testing.T) {
+	closeDate := func(day int) backtest.CustomTime {
+		return backtest.CustomTime{Time: time.Date(2024, 1, day, 12, 0, 0, 0, time.UTC)}
+	}
+	trades := []backtest.Trade{
+		{Pair: "BTC/USDT", ExitReason: "roi", CloseDate: closeDate(1), ProfitAbs: 2, ProfitRatio: 0.02},
+		{Pair: "BTC/USDT", ExitReason: "stop_loss", CloseDate: closeDate(2), ProfitAbs: -1, ProfitRatio: -0.01},
+		{Pair: "BTC/USDT", ExitReason: "roi", CloseDate: closeDate(8), ProfitAbs: 3, ProfitRatio: 0.03},
+	}
+
+	testCases := []struct {
+		name       string
+		weeks      []backtest.PeriodResult
+		mismatches int
+	}{
+		{
+			name: "matching weeks",
+			weeks: []backtest.PeriodResult{
+				{Date: backtest.CustomTime{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}, ProfitAbs: 2, Trades: 1, Wins: 1},
+				{Date: backtest.CustomTime{Time: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)}, ProfitAbs: 2, Trades: 2, Wins: 1, Losses: 1},
+			},
+		},
+		{
+			name: "weeks labelled by their first day",
+			weeks: []backtest.PeriodResult{
+				{Date: backtest.CustomTime{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}, ProfitAbs: 1, Trades: 2, Wins: 1, Losses: 1},
+				{Date: backtest.CustomTime{Time: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)}, ProfitAbs: 3, Trades: 1, Wins: 1},
+			},
+			mismatches: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := backtest.Strategy{
+				Trades:            trades,
+				PeriodicBreakdown: map[string][]backtest.PeriodResult{"week": tc.weeks},
+			}
+
+			check := StrategyCrossCheck(s)
+			if check == nil {
+				t.Fatal("expected a cross check")
+			}
+			if check.Mismatches != tc.mismatches {
+				t.Errorf("expected %d mismatches, got %d: %v", tc.mismatches, check.Mismatches, check.Warnings())
+			}
+			if len(check.Warnings()) != tc.mismatches {
+				t.Errorf("expected %d warnings, got %v", tc.mismatches, check.Warnings())
+			}
+		})
+	}
+
+	if StrategyCrossCheck(backtest.Strategy{Trades: trades}) != nil {
+		t.Error("expected no cross check without freqtrade results tables")
+	}
+}
@@ -0,0 +1,113 @@
+package report
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// TradeDiff represents the differences between the trades of two runs, A and B,
+// trades are matched by pair, open date and side.
+type TradeDiff struct {
+	TradesA int
+	TradesB int
+	ProfitA float64
+	ProfitB float64
+	Matched int
+	// Changed are the matched trades whose exit differs
+	Changed []TradeChange
+	OnlyA   []backtest.Trade
+	OnlyB   []backtest.Trade
+}
+
+// TradeChange represents a trade found in both runs with a different exit
+type TradeChange struct {
+	Pair        string
+	IsShort     bool
+	OpenDate    time.Time
+	ExitReasonA string
+	ExitReasonB string
+	CloseDateA  time.Time
+	CloseDateB  time.Time
+	ProfitA     float64
+	ProfitB     float64
+}
+
+// ProfitDelta returns the absolute profit difference of the trade, from A to B
+func (tc TradeChange) ProfitDelta() float64 {
+	return tc.ProfitB - tc.ProfitA
+}
+
+// tradeKey identifies a trade across runs
+type tradeKey struct {
+	pair     string
+	openDate int64
+	isShort  bool
+}
+
+// DiffTrades matches the trades of a and b and returns their differences.
+// Changed trades are sorted by decreasing profit difference magnitude,
+// trades found in a single run by decreasing profit magnitude.
+func DiffTrades(a, b backtest.Strategy) TradeDiff {
+	diff := TradeDiff{
+		TradesA: len(a.Trades),
+		TradesB: len(b.Trades),
+	}
+
+	// a key can be found multiple times, trades are then matched in order
+	pending := make(map[tradeKey][]backtest.Trade)
+	for _, t := range b.Trades {
+		key := tradeKey{pair: t.Pair, openDate: t.OpenDate.Unix(), isShort: t.IsShort}
+		pending[key] = append(pending[key], t)
+		diff.ProfitB += t.ProfitAbs
+	}
+
+	for _, ta := range a.Trades {
+		diff.ProfitA += ta.ProfitAbs
+
+		key := tradeKey{pair: ta.Pair, openDate: ta.OpenDate.Unix(), isShort: ta.IsShort}
+		if len(pending[key]) == 0 {
+			diff.OnlyA = append(diff.OnlyA, ta)
+			continue
+		}
+		tb := pending[key][0]
+		pending[key] = pending[key][1:]
+		diff.Matched++
+
+		if ta.ExitReason == tb.ExitReason && ta.CloseDate.Equal(tb.CloseDate.Time) && math.Abs(ta.ProfitRatio-tb.ProfitRatio) < 1e-9 {
+			continue
+		}
+		diff.Changed = append(diff.Changed, TradeChange{
+			Pair:        ta.Pair,
+			IsShort:     ta.IsShort,
+			OpenDate:    ta.OpenDate.Time,
+			ExitReasonA: ta.ExitReason,
+			ExitReasonB: tb.ExitReason,
+			CloseDateA:  ta.CloseDate.Time,
+			CloseDateB:  tb.CloseDate.Time,
+			ProfitA:     ta.ProfitAbs,
+			ProfitB:     tb.ProfitAbs,
+		})
+	}
+
+	for _, t := range b.Trades {
+		key := tradeKey{pair: t.Pair, openDate: t.OpenDate.Unix(), isShort: t.IsShort}
+		if len(pending[key]) > 0 {
+			diff.OnlyB = append(diff.OnlyB, pending[key][0])
+			pending[key] = pending[key][1:]
+		}
+	}
+
+	sort.SliceStable(diff.Changed, func(i, j int) bool {
+		return math.Abs(diff.Changed[i].ProfitDelta()) > math.Abs(diff.Changed[j].ProfitDelta())
+	})
+	for _, trades := range [][]backtest.Trade{diff.OnlyA, diff.OnlyB} {
+		sort.SliceStable(trades, func(i, j int) bool {
+			return math.Abs(trades[i].ProfitAbs) > math.Abs(trades[j].ProfitAbs)
+		})
+	}
+
+	return diff
+}
@@ -0,0 +1,121 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+func TestDiffTrades(t *testing.T) {
+	open := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	trade := func(pair string, isShort bool, exitReason string, profit float64) backtest.Trade {
+		return backtest.Trade{
+			Pair:        pair,
+			IsShort:     isShort,
+			OpenDate:    backtest.CustomTime{Time: open},
+			CloseDate:   backtest.CustomTime{Time: open.Add(time.Hour)},
+			ExitReason:  exitReason,
+			ProfitAbs:   profit,
+			ProfitRatio: profit / 100,
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		a       []backtest.Trade
+		b       []backtest.Trade
+		matched int
+		changed []TradeChange
+		onlyA   int
+		onlyB   int
+	}{
+		{
+			name:    "identical trades",
+			a:       []backtest.Trade{trade("BTC/USDT", false, "roi", 1)},
+			b:       []backtest.Trade{trade("BTC/USDT", false, "roi", 1)},
+			matched: 1,
+		},
+		{
+			name:    "changed exit",
+			a:       []backtest.Trade{trade("BTC/USDT", false, "roi", 1)},
+			b:       []backtest.Trade{trade("BTC/USDT", false, "stop_loss", -2)},
+			matched: 1,
+			changed: []TradeChange{{Pair: "BTC/USDT", ExitReasonA: "roi", ExitReasonB: "stop_loss", ProfitA: 1, ProfitB: -2}},
+		},
+		{
+			name:  "side is part of the key",
+			a:     []backtest.Trade{trade("BTC/USDT", false, "roi", 1)},
+			b:     []backtest.Trade{trade("BTC/USDT", true, "roi", 1)},
+			onlyA: 1,
+			onlyB: 1,
+		},
+		{
+			name: "duplicate keys are matched in order",
+			a: []backtest.Trade{
+				trade("BTC/USDT", false, "roi", 1),
+				trade("BTC/USDT", false, "exit_signal", 2),
+			},
+			b: []backtest.Trade{
+				trade("BTC/USDT", false, "roi", 1),
+				trade("BTC/USDT", false, "stop_loss", -3),
+			},
+			matched: 2,
+			changed: []TradeChange{{Pair: "BTC/USDT", ExitReasonA: "exit_signal", ExitReasonB: "stop_loss", ProfitA: 2, ProfitB: -3}},
+		},
+		{
+			name: "duplicate keys left over in A",
+			a: []backtest.Trade{
+				trade("BTC/USDT", false, "roi", 1),
+				trade("BTC/USDT", false, "roi", 1),
+				trade("BTC/USDT", false, "roi", 1),
+			},
+			b:       []backtest.Trade{trade("BTC/USDT", false, "roi", 1)},
+			matched: 1,
+			onlyA:   2,
+		},
+		{
+			name: "duplicate keys left over in B",
+			a:    []backtest.Trade{trade("ETH/USDT", false, "roi", 1)},
+			b: []backtest.Trade{
+				trade("ETH/USDT", false, "roi", 1),
+				trade("ETH/USDT", false, "roi", 4),
+				trade("BTC/USDT", false, "roi", 1),
+			},
+			matched: 1,
+			onlyB:   2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diff := DiffTrades(backtest.Strategy{Trades: tc.a}, backtest.Strategy{Trades: tc.b})
+
+			if diff.TradesA != len(tc.a) || diff.TradesB != len(tc.b) {
+				t.Errorf("expected %d and %d trades, got %d and %d", len(tc.a), len(tc.b), diff.TradesA, diff.TradesB)
+			}
+			if diff.Matched != tc.matched {
+				t.Errorf("expected %d matched trades, got %d", tc.matched, diff.Matched)
+			}
+			if len(diff.OnlyA) != tc.onlyA {
+				t.Errorf("expected %d trades only in A, got %d", tc.onlyA, len(diff.OnlyA))
+			}
+			if len(diff.OnlyB) != tc.onlyB {
+				t.Errorf("expected %d trades only in B, got %d", tc.onlyB, len(diff.OnlyB))
+			}
+			if diff.Matched+len(diff.OnlyA) != diff.TradesA || diff.Matched+len(diff.OnlyB) != diff.TradesB {
+				t.Errorf("expected every trade to be matched or reported once, got %d matched, %d only in A, %d only in B", diff.Matched, len(diff.OnlyA), len(diff.OnlyB))
+			}
+
+			if len(diff.Changed) != len(tc.changed) {
+				t.Fatalf("expected %d changed trades, got %d", len(tc.changed), len(diff.Changed))
+			}
+			for i, expected := range tc.changed {
+				c := diff.Changed[i]
+				if c.Pair != expected.Pair || c.ExitReasonA != expected.ExitReasonA || c.ExitReasonB != expected.ExitReasonB || c.ProfitA != expected.ProfitA || c.ProfitB != expected.ProfitB {
+					t.Errorf("expected changed trade %+v, got %+v", expected, c)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package report
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/stat"
+)
+
+// NewDistribution returns the Distribution of values, values are not modified
+func NewDistribution(values []float64) Distribution {
+	if len(values) == 0 {
+		return Distribution{}
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	d := Distribution{
+		Min:    sorted[0],
+		P5:     stat.Quantile(0.05, stat.Empirical, sorted, nil),
+		P25:    stat.Quantile(0.25, stat.Empirical, sorted, nil),
+		Median: stat.Quantile(0.5, stat.Empirical, sorted, nil),
+		P75:    stat.Quantile(0.75, stat.Empirical, sorted, nil),
+		P95:    stat.Quantile(0.95, stat.Empirical, sorted, nil),
+		Max:    sorted[len(sorted)-1],
+	}
+
+	// skew and kurtosis are undefined for small or constant samples
+	if len(sorted) > 3 && d.Max > d.Min {
+		d.Skew = stat.Skew(sorted, nil)
+		d.Kurtosis = stat.ExKurtosis(sorted, nil)
+	}
+
+	return d
+}
+
+// Histogram returns the distribution of values in bins of equal width, from the lowest to the highest value
+func Histogram(values []float64, bins int) []DistributionBucket {
+	if len(values) == 0 || bins < 1 {
+		return nil
+	}
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+	if hi == lo {
+		return []DistributionBucket{{Low: lo, High: hi, Count: len(values)}}
+	}
+
+	width := (hi - lo) / float64(bins)
+	buckets := make([]DistributionBucket, bins)
+	for i := range buckets {
+		buckets[i].Low = lo + float64(i)*width
+		buckets[i].High = lo + float64(i+1)*width
+	}
+	for _, v := range values {
+		i := int(math.Min(math.Floor((v-lo)/width), float64(bins-1)))
+		buckets[i].Count++
+	}
+
+	return buckets
+}
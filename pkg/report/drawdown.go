@@ -0,0 +1,99 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// drawdownTolerance is the relative difference allowed between the recomputed
+// drawdown figures and the ones reported by freqtrade
+const drawdownTolerance = 0.01
+
+// EquityCurve returns the balance after each closed trade, ordered by close date.
+// The curve starts with StartingBalance at BacktestStart.
+func EquityCurve(s backtest.Strategy) []EquityPoint {
+	trades := s.ClosedTrades()
+
+	curve := []EquityPoint{{Date: s.BacktestStart.Time, Balance: s.StartingBalance}}
+	balance := s.StartingBalance
+	for _, t := range trades {
+		balance = balance + t.ProfitAbs
+		curve = append(curve, EquityPoint{Date: t.CloseDate.Time, Balance: balance})
+	}
+
+	return curve
+}
+
+// StrategyDrawdownReport returns a DrawdownReport for the Strategy,
+// drawdown episodes are computed from the equity curve and sorted by depth.
+func StrategyDrawdownReport(s backtest.Strategy) DrawdownReport {
+	var report DrawdownReport
+
+	curve := EquityCurve(s)
+	peak := curve[0]
+	var episode *DrawdownEpisode
+	for _, p := range curve[1:] {
+		if p.Balance >= peak.Balance {
+			if episode != nil {
+				episode.Recovery = p.Date
+				episode.Recovered = true
+				episode.TimeToRecover = p.Date.Sub(episode.Trough)
+				episode.Duration = p.Date.Sub(episode.Start)
+				report.Episodes = append(report.Episodes, *episode)
+				episode = nil
+			}
+			peak = p
+			continue
+		}
+
+		if episode == nil {
+			episode = &DrawdownEpisode{
+				Start: peak.Date,
+				High:  peak.Balance,
+				Low:   math.Inf(1),
+			}
+		}
+
+		if p.Balance < episode.Low {
+			episode.Low = p.Balance
+			episode.Trough = p.Date
+			episode.Depth = episode.High - p.Balance
+			episode.DepthRelative = episode.Depth / episode.High
+		}
+	}
+	if episode != nil {
+		// the drawdown lasts until the end of the backtest
+		episode.Duration = curve[len(curve)-1].Date.Sub(episode.Start)
+		report.Episodes = append(report.Episodes, *episode)
+	}
+
+	sort.SliceStable(report.Episodes, func(i, j int) bool {
+		return report.Episodes[i].Depth > report.Episodes[j].Depth
+	})
+
+	for _, e := range report.Episodes {
+		report.MaxDrawdownAbs = math.Max(report.MaxDrawdownAbs, e.Depth)
+		report.MaxDrawdownRelative = math.Max(report.MaxDrawdownRelative, e.DepthRelative)
+	}
+
+	// Cross check with freqtrade figures
+	if !almostEqual(report.MaxDrawdownAbs, s.DrawdownAbs, drawdownTolerance) {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("absolute drawdown %.3f differs from freqtrade %.3f", report.MaxDrawdownAbs, s.DrawdownAbs))
+	}
+	if !almostEqual(report.MaxDrawdownRelative, s.DrawdownRelative, drawdownTolerance) {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("relative drawdown %.2f%% differs from freqtrade %.2f%%", report.MaxDrawdownRelative*100, s.DrawdownRelative*100))
+	}
+	return report
+}
+
+// almostEqual returns whether a and b are equal within the given relative tolerance
+func almostEqual(a, b, tolerance float64) bool {
+	if a == b {
+		return true
+	}
+
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
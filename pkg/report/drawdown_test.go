@@ -0,0 +1,95 @@
+package report
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+func TestStrategyDrawdownReport(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(d int) backtest.CustomTime {
+		return backtest.CustomTime{Time: start.AddDate(0, 0, d)}
+	}
+
+	testCases := []struct {
+		name          string
+		profits       []float64
+		recovered     bool
+		depth         float64
+		duration      time.Duration
+		timeToRecover time.Duration
+	}{
+		{
+			name:          "recovered",
+			profits:       []float64{10, -5, -15, 8, 30, 1},
+			recovered:     true,
+			depth:         20,
+			duration:      4 * 24 * time.Hour,
+			timeToRecover: 2 * 24 * time.Hour,
+		},
+		{
+			name:      "not recovered",
+			profits:   []float64{10, -5, -15, 8, 1, 1},
+			recovered: false,
+			depth:     20,
+			duration:  5 * 24 * time.Hour,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := backtest.Strategy{BacktestStart: backtest.CustomTime{Time: start}, StartingBalance: 100}
+			for i, p := range tc.profits {
+				s.Trades = append(s.Trades, backtest.Trade{CloseDate: day(i + 1), ProfitAbs: p})
+			}
+
+			report := StrategyDrawdownReport(s)
+			if len(report.Episodes) != 1 {
+				t.Fatalf("expected 1 drawdown episode, got %d", len(report.Episodes))
+			}
+
+			e := report.Episodes[0]
+			if !e.Start.Equal(day(1).Time) || !e.Trough.Equal(day(3).Time) {
+				t.Errorf("expected the drawdown from %s to %s, got %s to %s", day(1), day(3), e.Start, e.Trough)
+			}
+			if e.Recovered != tc.recovered {
+				t.Errorf("expected recovered %v, got %v", tc.recovered, e.Recovered)
+			}
+			if e.Depth != tc.depth {
+				t.Errorf("expected depth %v, got %v", tc.depth, e.Depth)
+			}
+			if e.Duration != tc.duration {
+				t.Errorf("expected duration %s, got %s", tc.duration, e.Duration)
+			}
+			if e.TimeToRecover != tc.timeToRecover {
+				t.Errorf("expected time to recover %s, got %s", tc.timeToRecover, e.TimeToRecover)
+			}
+		})
+	}
+}
+
+func TestStrategyDrawdownReportWarnings(t *testing.T) {
+	s := backtest.Strategy{
+		StartingBalance: 100,
+		DrawdownAbs:     50,
+		Trades:          []backtest.Trade{{CloseDate: backtest.CustomTime{Time: time.Now()}, ProfitAbs: -10}},
+	}
+
+	report := StrategyDrawdownReport(s)
+	if len(report.Warnings) != 2 {
+		t.Fatalf("expected absolute and relative drawdown warnings, got %v", report.Warnings)
+	}
+
+	// warnings are encoded once, along with the strategy report warnings
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "warnings") {
+		t.Errorf("expected drawdown warnings not to be encoded, got %s", data)
+	}
+}
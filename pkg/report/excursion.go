@@ -0,0 +1,42 @@
+package report
+
+import (
+	"math"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// leftOnTableEdges are the bucket edges of the profit left on the table distribution, in percent
+var leftOnTableEdges = []float64{0, 0.5, 1, 2, 3, 5, 10}
+
+// LeftOnTableDistribution returns the distribution of the profit left on the table
+// by closed trades, in percent. The last bucket holds every value above the last edge.
+func LeftOnTableDistribution(s backtest.Strategy) []DistributionBucket {
+	buckets := make([]DistributionBucket, len(leftOnTableEdges))
+	for i, edge := range leftOnTableEdges {
+		buckets[i].Low = edge
+		if i+1 < len(leftOnTableEdges) {
+			buckets[i].High = leftOnTableEdges[i+1]
+		} else {
+			buckets[i].High = edge
+		}
+	}
+
+	last := &buckets[len(buckets)-1]
+	for _, t := range s.Trades {
+		if t.IsOpen {
+			continue
+		}
+
+		left := t.LeftOnTable() * 100
+		for i := range buckets {
+			if left < buckets[i].High || i == len(buckets)-1 {
+				buckets[i].Count++
+				break
+			}
+		}
+		last.High = math.Max(last.High, left)
+	}
+
+	return buckets
+}
@@ -0,0 +1,146 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// ParamSensitivity represents how the score changes across the values of a hyperparameter
+type ParamSensitivity struct {
+	Param  string
+	Groups []ParamGroup
+}
+
+// ParamGroup represents the epochs sharing a parameter value, or a range of values
+type ParamGroup struct {
+	Value     string
+	Epochs    int
+	MeanScore float64
+	BestScore float64
+}
+
+// SortEpochs sorts epochs by descending score, NaN scores are last
+func SortEpochs(epochs []backtest.HyperoptEpoch) {
+	sort.SliceStable(epochs, func(i, j int) bool {
+		a, b := epochs[i].Score, epochs[j].Score
+		if math.IsNaN(a) || math.IsNaN(b) {
+			return !math.IsNaN(a)
+		}
+		return a > b
+	})
+}
+
+// HyperoptParamSensitivity returns the score sensitivity of every hyperparameter.
+// Numeric parameters with more distinct values than buckets are grouped in equal width ranges.
+func HyperoptParamSensitivity(epochs []backtest.HyperoptEpoch, buckets int) []ParamSensitivity {
+	params := make(map[string]bool)
+	for _, e := range epochs {
+		for p := range e.ParamsDict {
+			params[p] = true
+		}
+	}
+
+	names := make([]string, 0, len(params))
+	for p := range params {
+		names = append(names, p)
+	}
+	sort.Strings(names)
+
+	var sensitivities []ParamSensitivity
+	for _, name := range names {
+		sensitivities = append(sensitivities, paramSensitivity(epochs, name, buckets))
+	}
+
+	return sensitivities
+}
+
+// paramSensitivity returns the score sensitivity of a single hyperparameter
+func paramSensitivity(epochs []backtest.HyperoptEpoch, name string, buckets int) ParamSensitivity {
+	numeric := true
+	distinct := make(map[string]bool)
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, e := range epochs {
+		v, ok := e.ParamsDict[name]
+		if !ok {
+			continue
+		}
+		distinct[fmt.Sprint(v)] = true
+		f, ok := v.(float64)
+		if !ok {
+			numeric = false
+			continue
+		}
+		lo = math.Min(lo, f)
+		hi = math.Max(hi, f)
+	}
+
+	bucketed := numeric && buckets > 0 && len(distinct) > buckets && hi > lo
+	width := (hi - lo) / float64(buckets)
+
+	// group key is used to sort groups, numerically when possible
+	type group struct {
+		ParamGroup
+		key    float64
+		scores []float64
+	}
+	groups := make(map[string]*group)
+	for _, e := range epochs {
+		v, ok := e.ParamsDict[name]
+		if !ok {
+			continue
+		}
+
+		label := fmt.Sprint(v)
+		key := 0.0
+		if f, ok := v.(float64); ok && numeric {
+			key = f
+			if bucketed {
+				i := math.Min(math.Floor((f-lo)/width), float64(buckets-1))
+				key = lo + i*width
+				label = fmt.Sprintf("%.4g - %.4g", key, key+width)
+			}
+		}
+
+		g, ok := groups[label]
+		if !ok {
+			g = &group{ParamGroup: ParamGroup{Value: label, BestScore: math.NaN()}, key: key}
+			groups[label] = g
+		}
+		g.Epochs++
+		if !math.IsNaN(e.Score) {
+			g.scores = append(g.scores, e.Score)
+			if math.IsNaN(g.BestScore) || e.Score > g.BestScore {
+				g.BestScore = e.Score
+			}
+		}
+	}
+
+	sorted := make([]*group, 0, len(groups))
+	for _, g := range groups {
+		var total float64
+		for _, s := range g.scores {
+			total = total + s
+		}
+		g.MeanScore = math.NaN()
+		if len(g.scores) > 0 {
+			g.MeanScore = total / float64(len(g.scores))
+		}
+		sorted = append(sorted, g)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if numeric {
+			return sorted[i].key < sorted[j].key
+		}
+		return sorted[i].Value < sorted[j].Value
+	})
+
+	ps := ParamSensitivity{Param: name}
+	for _, g := range sorted {
+		ps.Groups = append(ps.Groups, g.ParamGroup)
+	}
+
+	return ps
+}
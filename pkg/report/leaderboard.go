@@ -0,0 +1,32 @@
+package report
+
+import (
+	"math"
+	"sort"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// LeaderboardEntry represents a single strategy of a backtest result in the leaderboard,
+// Value is the metric used for ranking.
+type LeaderboardEntry struct {
+	File     string
+	Name     string
+	Value    float64
+	Score    float64
+	Strategy backtest.Strategy
+}
+
+// SortLeaderboard sorts entries by value, NaN values are always last
+func SortLeaderboard(entries []LeaderboardEntry, ascending bool) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].Value, entries[j].Value
+		if math.IsNaN(a) || math.IsNaN(b) {
+			return !math.IsNaN(a)
+		}
+		if ascending {
+			return a < b
+		}
+		return a > b
+	})
+}
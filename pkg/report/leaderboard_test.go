@@ -0,0 +1,42 @@
+package report
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSortLeaderboard(t *testing.T) {
+	nan := math.NaN()
+	testCases := []struct {
+		name      string
+		values    []float64
+		ascending bool
+		expected  []string
+	}{
+		{name: "descending", values: []float64{1, 3, 2}, expected: []string{"b", "c", "a"}},
+		{name: "ascending", values: []float64{1, 3, 2}, ascending: true, expected: []string{"a", "c", "b"}},
+		{name: "NaN last when descending", values: []float64{nan, 1, 2}, expected: []string{"c", "b", "a"}},
+		{name: "NaN last when ascending", values: []float64{nan, 2, 1}, ascending: true, expected: []string{"c", "b", "a"}},
+		{name: "ties keep their order", values: []float64{1, 1, nan, 1}, expected: []string{"a", "b", "d", "c"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var entries []LeaderboardEntry
+			for i, v := range tc.values {
+				entries = append(entries, LeaderboardEntry{Name: string(rune('a' + i)), Value: v})
+			}
+
+			SortLeaderboard(entries, tc.ascending)
+
+			var names []string
+			for _, e := range entries {
+				names = append(names, e.Name)
+			}
+			if !reflect.DeepEqual(names, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, names)
+			}
+		})
+	}
+}
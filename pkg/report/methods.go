@@ -0,0 +1,244 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/internal/jsonfloat"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/stat"
+)
+
+// GetReportIndexByReason returns the ExitReasonReport with the given reason
+func (ers *ExitReasonReports) GetReportIndexByReason(reason string) *ExitReasonReport {
+	for i := range *ers {
+		if (*ers)[i].Reason == reason {
+			return &(*ers)[i]
+		}
+	}
+
+	return nil
+}
+
+// AddTrade adds a trade to the ExitReasonReports
+func (ers *ExitReasonReports) AddTrade(t backtest.Trade, reasons []string, reasonIndex int) int {
+	var zeroDuration = 0
+	reason := reasons[reasonIndex]
+	if !t.IsOpen {
+		var er *ExitReasonReport
+		er = ers.GetReportIndexByReason(reason)
+		if er == nil {
+			*ers = append(*ers, ExitReasonReport{
+				Reason: reasons[reasonIndex],
+			})
+			er = ers.GetReportIndexByReason(reason)
+		}
+
+		er.Exits++
+		if t.TradeDuration > 0 {
+			er.TradeDurations = append(er.TradeDurations, t.TradeDuration)
+		} else {
+			zeroDuration = zeroDuration + 1
+		}
+		er.ProfitAbs = append(er.ProfitAbs, t.ProfitAbs)
+		er.MAE = append(er.MAE, t.MAE())
+		er.MFE = append(er.MFE, t.MFE())
+		er.LeftOnTable = append(er.LeftOnTable, t.LeftOnTable())
+
+		if len(reasons) > reasonIndex+1 {
+			zd := er.ExitReasonReports.AddTrade(t, reasons, reasonIndex+1)
+			zeroDuration = zeroDuration + zd
+		}
+	}
+	return zeroDuration
+}
+
+// Compute computes values for the ExitReasonReports
+// such as total profit, average profit, average duration, and standard deviation of duration
+func (ers *ExitReasonReports) Compute() {
+	var absoluteTotal float64 = 0
+	for k := range *ers {
+		var er *ExitReasonReport
+		er = &(*ers)[k]
+
+		var totalProfit float64 = 0
+		for _, value := range er.ProfitAbs {
+			totalProfit = totalProfit + value
+		}
+		absoluteTotal = absoluteTotal + math.Abs(totalProfit)
+		er.TotalProfit = totalProfit
+		er.AvgProfit = totalProfit / float64(len(er.ProfitAbs))
+
+		var totalDuration = 0
+		for _, value := range er.TradeDurations {
+			totalDuration = totalDuration + value
+		}
+
+		lenTradeDurations := len(er.TradeDurations)
+		if lenTradeDurations == 0 {
+			lenTradeDurations = 1
+		}
+		er.AvgDuration = totalDuration / lenTradeDurations
+
+		tDurations := []float64{}
+		for _, v := range er.TradeDurations {
+			tDurations = append(tDurations, float64(v))
+		}
+		if len(tDurations) > 1 {
+			er.StdDevDuration = stat.StdDev(tDurations, nil)
+		}
+
+		er.ProfitDistribution = NewDistribution(er.ProfitAbs)
+		er.DurationDistribution = NewDistribution(tDurations)
+
+		// excursions, as percentages
+		er.AvgMAE = stat.Mean(er.MAE, nil) * 100
+		er.WorstMAE = minFloat(er.MAE) * 100
+		er.AvgMFE = stat.Mean(er.MFE, nil) * 100
+		er.BestMFE = maxFloat(er.MFE) * 100
+		er.AvgLeftOnTable = stat.Mean(er.LeftOnTable, nil) * 100
+		leftOnTable := NewDistribution(er.LeftOnTable)
+		er.MedianLeftOnTable = leftOnTable.Median * 100
+		er.P90LeftOnTable = quantile(er.LeftOnTable, 0.9) * 100
+	}
+
+	for k := range *ers {
+		er := &(*ers)[k]
+
+		if absoluteTotal > 0 {
+			er.TotalProfitPercentage = er.TotalProfit / absoluteTotal * 100
+		}
+
+		er.ExitReasonReports.Compute()
+	}
+}
+
+// NewStrategyReport computes the metrics, the score and the selected reports of the named Strategy
+func NewStrategyReport(name string, s backtest.Strategy, opts Options) StrategyReport {
+	var strategyReport StrategyReport
+
+	// Sort ROI by value, so we can break down ROI exit by value.
+	// e.g. roi setting -> 0:0.1  60:0.02; 100 roi exits does not tell anything
+	//      but 80 exit >= 0.1 20  exit >= 0.02 tells how many exits per ROI setting
+	s.SortMinimalROI()
+
+	strategyReport.Strategy = name
+	strategyReport.Metrics = Metrics(s)
+	strategyReport.ScoreBreakdown = opts.scoreModel().Breakdown(s)
+	strategyReport.Score = jsonfloat.Finite(strategyReport.ScoreBreakdown.Score)
+	for _, d := range definitions {
+		if d.Compute != nil && opts.selected(d.Name) {
+			d.Compute(&strategyReport, s, opts)
+		}
+	}
+
+	return strategyReport
+}
+
+// GetExitReasons returns the exit reasons for a trade of the Strategy,
+// roi exits are broken down by the roi step they reached.
+func GetExitReasons(s backtest.Strategy, t backtest.Trade) []string {
+	if t.ExitReason == "roi" {
+		for _, mr := range s.MinimalROISorted {
+			if t.ProfitRatio >= mr.Value {
+				return []string{"roi", fmt.Sprintf("roi %s:%.3f", mr.Name, mr.Value)}
+			}
+		}
+
+		if t.ProfitRatio >= 0.02 {
+			return []string{"roi", "roi art:0.02"}
+		}
+		if t.ProfitRatio >= 0.01 {
+			return []string{"roi", "roi art:0.01"}
+		}
+		return []string{"roi", "roi inf+"}
+	}
+
+	return []string{t.ExitReason}
+}
+
+// StrategyExitReasonReport returns an ExitReasonReports for the Strategy,
+// MinimalROISorted must be set to break roi exits down by step.
+func StrategyExitReasonReport(s backtest.Strategy) ExitReasonReports {
+	var exitReasonReports ExitReasonReports
+
+	for _, t := range s.Trades {
+		exitReasonReports.AddTrade(t, GetExitReasons(s, t), 0)
+	}
+
+	exitReasonReports.Compute()
+
+	return exitReasonReports
+}
+
+// StrategyEnterTagReport returns an ExitReasonReports for the Strategy
+// grouped by enter tag, the hierarchy is enter tag -> exit reason -> ROI step.
+func StrategyEnterTagReport(s backtest.Strategy) ExitReasonReports {
+	var enterTagReports ExitReasonReports
+
+	for _, t := range s.Trades {
+		reasons := append([]string{t.GetEnterTag()}, GetExitReasons(s, t)...)
+		enterTagReports.AddTrade(t, reasons, 0)
+	}
+
+	enterTagReports.Compute()
+
+	return enterTagReports
+}
+
+// ExitReasons returns the sorted list of reasons found at the given depth of the ExitReasonReports tree
+func (ers ExitReasonReports) ExitReasons(depth int) []string {
+	seen := make(map[string]bool)
+	var reasons []string
+	for _, er := range ers {
+		if depth > 0 {
+			for _, r := range er.ExitReasonReports.ExitReasons(depth - 1) {
+				if !seen[r] {
+					seen[r] = true
+					reasons = append(reasons, r)
+				}
+			}
+			continue
+		}
+		if !seen[er.Reason] {
+			seen[er.Reason] = true
+			reasons = append(reasons, er.Reason)
+		}
+	}
+
+	sort.Strings(reasons)
+
+	return reasons
+}
+
+// quantile returns the p quantile of values, values are not modified
+func quantile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	return stat.Quantile(p, stat.Empirical, sorted, nil)
+}
+
+// minFloat returns the lowest of values, 0 when empty
+func minFloat(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	return floats.Min(values)
+}
+
+// maxFloat returns the highest of values, 0 when empty
+func maxFloat(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	return floats.Max(values)
+}
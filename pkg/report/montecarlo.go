@@ -0,0 +1,157 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"runtime"
+	"sync"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// Monte Carlo resampling modes
+const (
+	// ResampleShuffle reorders the trades, final balance is unchanged
+	ResampleShuffle = "shuffle"
+	// ResampleBootstrap draws trades with replacement
+	ResampleBootstrap = "bootstrap"
+)
+
+// MonteCarloOptions holds the Monte Carlo simulation parameters
+type MonteCarloOptions struct {
+	Simulations int
+	Mode        string
+	Seed        uint64
+}
+
+// resample returns n indexes drawn from [0, n), either as a permutation (shuffle)
+// or with replacement (bootstrap).
+func resample(rng *rand.Rand, n int, mode string) []int {
+	if mode == ResampleShuffle {
+		return rng.Perm(n)
+	}
+
+	indexes := make([]int, n)
+	for i := range indexes {
+		indexes[i] = rng.IntN(n)
+	}
+
+	return indexes
+}
+
+// simulationRand returns the random generator of a simulation, seeded with
+// the seed and the simulation index so results do not depend on scheduling.
+func simulationRand(seed uint64, simulation int) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, uint64(simulation)))
+}
+
+// runSimulations runs simulations concurrently across cores, calling fn for each simulation index
+func runSimulations(simulations int, fn func(i int)) {
+	workers := runtime.NumCPU()
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < simulations; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// sequenceOutcome returns the final balance, the max drawdown (absolute and relative to the peak)
+// and the longest losing streak of a sequence of trade profits starting at balance.
+func sequenceOutcome(balance float64, profits []float64) MonteCarloOutcome {
+	outcome := MonteCarloOutcome{}
+	peak := balance
+	streak := 0
+	for _, p := range profits {
+		balance = balance + p
+		if balance > peak {
+			peak = balance
+		}
+		if dd := peak - balance; dd > outcome.MaxDrawdownAbs {
+			outcome.MaxDrawdownAbs = dd
+		}
+		if peak > 0 {
+			outcome.MaxDrawdownRelative = math.Max(outcome.MaxDrawdownRelative, (peak-balance)/peak)
+		}
+
+		if p < 0 {
+			streak++
+			outcome.LongestLosingStreak = max(outcome.LongestLosingStreak, streak)
+		} else {
+			streak = 0
+		}
+	}
+	outcome.FinalBalance = balance
+
+	return outcome
+}
+
+// MonteCarlo resamples the closed trade sequence and returns the distributions
+// of final balance, max drawdown and longest losing streak across simulations.
+func MonteCarlo(s backtest.Strategy, opts MonteCarloOptions) (MonteCarloReport, error) {
+	if opts.Mode != ResampleShuffle && opts.Mode != ResampleBootstrap {
+		return MonteCarloReport{}, fmt.Errorf("unknown resampling mode %q, expecting shuffle|bootstrap", opts.Mode)
+	}
+
+	trades := s.ClosedTrades()
+	profits := make([]float64, len(trades))
+	for i, t := range trades {
+		profits[i] = t.ProfitAbs
+	}
+
+	report := MonteCarloReport{
+		Simulations: opts.Simulations,
+		Mode:        opts.Mode,
+		Seed:        opts.Seed,
+		Actual:      sequenceOutcome(s.StartingBalance, profits),
+	}
+	if len(profits) == 0 || opts.Simulations < 1 {
+		return report, nil
+	}
+
+	outcomes := make([]MonteCarloOutcome, opts.Simulations)
+	runSimulations(opts.Simulations, func(i int) {
+		rng := simulationRand(opts.Seed, i)
+		sample := make([]float64, len(profits))
+		for j, k := range resample(rng, len(profits), opts.Mode) {
+			sample[j] = profits[k]
+		}
+		outcomes[i] = sequenceOutcome(s.StartingBalance, sample)
+	})
+
+	finalBalances := make([]float64, len(outcomes))
+	drawdownsAbs := make([]float64, len(outcomes))
+	drawdownsRelative := make([]float64, len(outcomes))
+	streaks := make([]float64, len(outcomes))
+	losses := 0
+	for i, o := range outcomes {
+		finalBalances[i] = o.FinalBalance
+		drawdownsAbs[i] = o.MaxDrawdownAbs
+		drawdownsRelative[i] = o.MaxDrawdownRelative
+		streaks[i] = float64(o.LongestLosingStreak)
+		if o.FinalBalance < s.StartingBalance {
+			losses++
+		}
+	}
+
+	report.FinalBalance = NewDistribution(finalBalances)
+	report.MaxDrawdownAbs = NewDistribution(drawdownsAbs)
+	report.MaxDrawdownRelative = NewDistribution(drawdownsRelative)
+	report.LongestLosingStreak = NewDistribution(streaks)
+	report.LossProbability = float64(losses) / float64(len(outcomes))
+
+	return report, nil
+}
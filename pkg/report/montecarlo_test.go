@@ -0,0 +1,91 @@
+package report
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+func TestSequenceOutcome(t *testing.T) {
+	testCases := []struct {
+		name     string
+		balance  float64
+		profits  []float64
+		expected MonteCarloOutcome
+	}{
+		{name: "no trade", balance: 100, expected: MonteCarloOutcome{FinalBalance: 100}},
+		{name: "only wins", balance: 100, profits: []float64{10, 5}, expected: MonteCarloOutcome{FinalBalance: 115}},
+		{
+			name:     "drawdown from the peak",
+			balance:  100,
+			profits:  []float64{20, -30, 5, -15, 50},
+			expected: MonteCarloOutcome{FinalBalance: 130, MaxDrawdownAbs: 40, MaxDrawdownRelative: 40.0 / 120, LongestLosingStreak: 1},
+		},
+		{
+			name:     "losing streak reset by a break-even trade",
+			balance:  100,
+			profits:  []float64{-1, -1, 0, -1, -1, -1},
+			expected: MonteCarloOutcome{FinalBalance: 95, MaxDrawdownAbs: 5, MaxDrawdownRelative: 0.05, LongestLosingStreak: 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			outcome := sequenceOutcome(tc.balance, tc.profits)
+			if math.Abs(outcome.FinalBalance-tc.expected.FinalBalance) > 1e-9 ||
+				math.Abs(outcome.MaxDrawdownAbs-tc.expected.MaxDrawdownAbs) > 1e-9 ||
+				math.Abs(outcome.MaxDrawdownRelative-tc.expected.MaxDrawdownRelative) > 1e-9 ||
+				outcome.LongestLosingStreak != tc.expected.LongestLosingStreak {
+				t.Errorf("expected %+v, got %+v", tc.expected, outcome)
+			}
+		})
+	}
+}
+
+func TestMonteCarlo(t *testing.T) {
+	var trades []backtest.Trade
+	for _, profit := range []float64{12, -8, 3, -5, 20, -1, -9, 4} {
+		trades = append(trades, backtest.Trade{ProfitAbs: profit})
+	}
+	s := backtest.Strategy{StartingBalance: 100, Trades: trades}
+
+	t.Run("shuffle keeps the final balance", func(t *testing.T) {
+		report, err := MonteCarlo(s, MonteCarloOptions{Simulations: 200, Mode: ResampleShuffle, Seed: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(report.FinalBalance.Min-116) > 1e-9 || math.Abs(report.FinalBalance.Max-116) > 1e-9 {
+			t.Errorf("expected every final balance to be 116, got %+v", report.FinalBalance)
+		}
+		if report.Actual.FinalBalance != 116 || report.LossProbability != 0 {
+			t.Errorf("expected an actual final balance of 116 without loss, got %+v and %v", report.Actual, report.LossProbability)
+		}
+	})
+
+	t.Run("bootstrap is reproducible with a seed", func(t *testing.T) {
+		opts := MonteCarloOptions{Simulations: 200, Mode: ResampleBootstrap, Seed: 7}
+		a, err := MonteCarlo(s, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := MonteCarlo(s, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(a, b) {
+			t.Errorf("expected the same report for the same seed, got %+v and %+v", a, b)
+		}
+		if a.FinalBalance.Min >= a.FinalBalance.Max {
+			t.Errorf("expected final balances to vary, got %+v", a.FinalBalance)
+		}
+	})
+
+	t.Run("unknown mode", func(t *testing.T) {
+		_, err := MonteCarlo(s, MonteCarloOptions{Simulations: 1, Mode: "permute"})
+		if err == nil {
+			t.Error("expected an error for an unknown mode")
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package report
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// Reports returns the StrategyReport of every strategy of the BacktestResult, sorted by strategy name
+func Reports(br *backtest.BacktestResult, opts Options) []StrategyReport {
+	names := br.StrategyNames()
+	reports := make([]StrategyReport, 0, len(names))
+	for _, name := range names {
+		reports = append(reports, NewStrategyReport(name, br.Strategy[name], opts))
+	}
+
+	return reports
+}
+
+// Metrics returns the general metrics of the Strategy
+func Metrics(s backtest.Strategy) StrategyMetrics {
+	return StrategyMetrics{
+		BacktestStart:      s.BacktestStart.Time,
+		BacktestEnd:        s.BacktestEnd.Time,
+		BacktestDays:       s.BacktestDays,
+		MaxOpenTrades:      s.MaxOpenTrades,
+		MinimalROI:         s.MinimalROI,
+		Stoploss:           s.Stoploss,
+		StakeCurrency:      s.StakeCurrency,
+		TotalTrades:        s.TotalTrades,
+		StartingBalance:    s.StartingBalance,
+		FinalBalance:       s.FinalBalance,
+		ProfitTotalAbs:     s.ProfitTotalAbs,
+		ProfitTotal:        s.ProfitTotal,
+		ProfitMean:         s.ProfitMean,
+		ProfitFactor:       s.ProfitFactor,
+		CAGR:               s.CAGR,
+		Sortino:            s.Sortino,
+		Sharpe:             s.Sharpe,
+		Calmar:             s.Calmar,
+		Expectancy:         s.Expectancy,
+		TradesPerDay:       s.TradesPerDay,
+		AvgStakeAmount:     s.AvgStakeAmount,
+		TotalVolume:        s.TotalVolume,
+		TradeCountLong:     s.TradeCountLong,
+		TradeCountShort:    s.TradeCountShort,
+		ProfitTotalLong:    s.ProfitTotalLong,
+		ProfitTotalShort:   s.ProfitTotalShort,
+		Wins:               s.Wins,
+		Draws:              s.Draws,
+		Losses:             s.Losses,
+		DrawdownRelative:   s.DrawdownRelative,
+		DrawdownAbsAccount: s.DrawdownAbsAccount,
+		DrawdownAbs:        s.DrawdownAbs,
+		DrawdownStart:      s.DrawdownStart.Time,
+		DrawdownEnd:        s.DrawdownEnd.Time,
+		MarketChange:       s.MarketChange,
+	}
+}
+
+// MarshalJSON encodes durations in seconds and the recovery date as null
+// when the drawdown did not recover.
+func (de DrawdownEpisode) MarshalJSON() ([]byte, error) {
+	type episode DrawdownEpisode
+	var recovery *time.Time
+	if de.Recovered {
+		recovery = &de.Recovery
+	}
+
+	return json.Marshal(struct {
+		episode
+		Recovery      *time.Time `json:"recovery"`
+		Duration      float64    `json:"duration_s"`
+		TimeToRecover float64    `json:"time_to_recover_s"`
+	}{
+		episode:       episode(de),
+		Recovery:      recovery,
+		Duration:      de.Duration.Seconds(),
+		TimeToRecover: de.TimeToRecover.Seconds(),
+	})
+}
@@ -0,0 +1,99 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// pairReportColumns maps the pair report columns to a comparison function used for sorting
+var pairReportColumns = map[string]func(a, b PairReport) bool{
+	"pair":          func(a, b PairReport) bool { return a.Pair < b.Pair },
+	"trades":        func(a, b PairReport) bool { return a.Trades < b.Trades },
+	"avg profit %":  func(a, b PairReport) bool { return a.AvgProfit < b.AvgProfit },
+	"tot profit":    func(a, b PairReport) bool { return a.TotalProfit < b.TotalProfit },
+	"tot profit %":  func(a, b PairReport) bool { return a.TotalProfitPercentage < b.TotalProfitPercentage },
+	"win %":         func(a, b PairReport) bool { return a.WinRate < b.WinRate },
+	"avg duration":  func(a, b PairReport) bool { return a.AvgDuration < b.AvgDuration },
+	"worst trade %": func(a, b PairReport) bool { return a.WorstTrade < b.WorstTrade },
+	"dd contrib %":  func(a, b PairReport) bool { return a.DrawdownContribution < b.DrawdownContribution },
+}
+
+// StrategyPairReport returns a PairReports for the Strategy
+func StrategyPairReport(s backtest.Strategy) PairReports {
+	var pairReports PairReports
+
+	index := make(map[string]int)
+	for _, t := range s.Trades {
+		if t.IsOpen {
+			continue
+		}
+
+		i, ok := index[t.Pair]
+		if !ok {
+			pairReports = append(pairReports, PairReport{
+				Pair:       t.Pair,
+				WorstTrade: t.ProfitRatio * 100,
+			})
+			i = len(pairReports) - 1
+			index[t.Pair] = i
+		}
+		pr := &pairReports[i]
+
+		pr.Trades++
+		pr.AvgProfit = pr.AvgProfit + t.ProfitRatio*100
+		pr.TotalProfit = pr.TotalProfit + t.ProfitAbs
+		pr.AvgDuration = pr.AvgDuration + t.TradeDuration
+		switch {
+		case t.ProfitAbs > 0:
+			pr.Wins++
+		case t.ProfitAbs < 0:
+			pr.Losses++
+		default:
+			pr.Draws++
+		}
+		if t.ProfitRatio*100 < pr.WorstTrade {
+			pr.WorstTrade = t.ProfitRatio * 100
+		}
+
+		// trades closed during the max drawdown period contribute to it
+		if s.DrawdownAbs > 0 && !t.CloseDate.Before(s.DrawdownStart.Time) && !t.CloseDate.After(s.DrawdownEnd.Time) {
+			pr.DrawdownContribution = pr.DrawdownContribution - t.ProfitAbs/s.DrawdownAbs*100
+		}
+	}
+
+	for i := range pairReports {
+		pr := &pairReports[i]
+		pr.AvgProfit = pr.AvgProfit / float64(pr.Trades)
+		pr.AvgDuration = pr.AvgDuration / pr.Trades
+		pr.WinRate = float64(pr.Wins) / float64(pr.Trades) * 100
+		if s.StartingBalance > 0 {
+			pr.TotalProfitPercentage = pr.TotalProfit / s.StartingBalance * 100
+		}
+	}
+
+	return pairReports
+}
+
+// SortBy sorts the PairReports using the given column name,
+// the column can be suffixed with ":asc" or ":desc" (default) to set the order.
+func (prs PairReports) SortBy(column string) error {
+	name, order, _ := strings.Cut(strings.ToLower(column), ":")
+	less, ok := pairReportColumns[strings.TrimSpace(name)]
+	if !ok {
+		return fmt.Errorf("unknown pair report column %q", name)
+	}
+
+	switch order {
+	case "asc":
+		sort.SliceStable(prs, func(i, j int) bool { return less(prs[i], prs[j]) })
+	case "", "desc":
+		sort.SliceStable(prs, func(i, j int) bool { return less(prs[j], prs[i]) })
+	default:
+		return fmt.Errorf("unknown pair report sort order %q, expecting asc|desc", order)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package report
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// Definition describes a report of a strategy
+type Definition struct {
+	Name        string
+	Description string
+	// Optional reports are only shown when explicitly selected
+	Optional bool
+	// Compute adds the report to the StrategyReport, it is nil for reports only using
+	// the metrics and score computed for every strategy.
+	Compute func(sr *StrategyReport, s backtest.Strategy, opts Options)
+}
+
+// definitions are the registered reports, in display order
+var definitions = []Definition{
+	{Name: "exits", Description: "exits by exit reason", Compute: computeExitReasons},
+	{Name: "roi", Description: "roi exits by roi step", Compute: computeExitReasons},
+	{Name: "pairs", Description: "per-pair results", Compute: func(sr *StrategyReport, s backtest.Strategy, opts Options) {
+		sr.PairReports = StrategyPairReport(s)
+	}},
+	{Name: "enter-tags", Description: "exits by enter tag, nested and as a matrix", Compute: func(sr *StrategyReport, s backtest.Strategy, opts Options) {
+		sr.EnterTagReports = StrategyEnterTagReport(s)
+	}},
+	{Name: "breakdown", Description: "profit per period, see --breakdown", Compute: func(sr *StrategyReport, s backtest.Strategy, opts Options) {
+		for _, period := range opts.Breakdowns {
+			sr.BreakdownReports = append(sr.BreakdownReports, StrategyBreakdownReport(s, period))
+		}
+	}},
+	{Name: "drawdowns", Description: "drawdown episodes", Compute: func(sr *StrategyReport, s backtest.Strategy, opts Options) {
+		sr.DrawdownReport = StrategyDrawdownReport(s)
+		sr.Warnings = append(sr.Warnings, sr.DrawdownReport.Warnings...)
+	}},
+	{Name: "roi-simulation", Description: "alternative roi table what-if, see --roi-table", Compute: func(sr *StrategyReport, s backtest.Strategy, opts Options) {
+		if opts.ROITable != nil {
+			simulation := SimulateROI(s, opts.ROITable)
+			sr.ROISimulation = &simulation
+		}
+	}},
+	{Name: "stoploss-simulation", Description: "stoploss what-if, see --stoploss-sweep and --trailing-stop", Compute: func(sr *StrategyReport, s backtest.Strategy, opts Options) {
+		if len(opts.StoplossSweep) > 0 || opts.TrailingStop != nil {
+			stoplosses := opts.StoplossSweep
+			if len(stoplosses) == 0 {
+				stoplosses = []float64{s.Stoploss}
+			}
+			simulation := SimulateStoploss(s, stoplosses, opts.TrailingStop)
+			sr.StoplossSimulation = &simulation
+		}
+	}},
+	{Name: "excursions", Description: "MAE / MFE by exit reason and profit left on the table", Optional: true, Compute: func(sr *StrategyReport, s backtest.Strategy, opts Options) {
+		computeExitReasons(sr, s, opts)
+		sr.LeftOnTable = LeftOnTableDistribution(s)
+	}},
+	{Name: "histograms", Description: "profit and duration distributions by exit reason", Optional: true, Compute: computeExitReasons},
+	{Name: "montecarlo", Description: "Monte Carlo simulations, see --montecarlo", Compute: func(sr *StrategyReport, s backtest.Strategy, opts Options) {
+		if opts.MonteCarlo.Simulations > 0 {
+			monteCarlo, err := MonteCarlo(s, opts.MonteCarlo)
+			if err != nil {
+				sr.Warnings = append(sr.Warnings, err.Error())
+				return
+			}
+			sr.MonteCarlo = &monteCarlo
+		}
+	}},
+	{Name: "bootstrap", Description: "bootstrap confidence intervals, see --bootstrap", Compute: func(sr *StrategyReport, s backtest.Strategy, opts Options) {
+		if opts.Bootstrap.Samples > 0 {
+			bootstrap := Bootstrap(s, opts.Bootstrap, opts.scoreModel())
+			sr.Bootstrap = &bootstrap
+		}
+	}},
+	{Name: "freqtrade", Description: "freqtrade results tables checked against the recomputed trades", Compute: func(sr *StrategyReport, s backtest.Strategy, opts Options) {
+		sr.CrossCheck = StrategyCrossCheck(s)
+		if sr.CrossCheck != nil {
+			sr.Warnings = append(sr.Warnings, sr.CrossCheck.Warnings()...)
+		}
+	}},
+	{Name: "winloss", Description: "wins, draws and losses"},
+	{Name: "metrics", Description: "general metrics"},
+	{Name: "score", Description: "score breakdown"},
+}
+
+// computeExitReasons computes the exit reason reports, once as several reports rely on them
+func computeExitReasons(sr *StrategyReport, s backtest.Strategy, opts Options) {
+	if sr.ExitReasonReports == nil {
+		sr.ExitReasonReports = StrategyExitReasonReport(s)
+		if n := zeroDurationTrades(s); n > 0 {
+			sr.Warnings = append(sr.Warnings, fmt.Sprintf("%d trades have duration=0", n))
+		}
+	}
+}
+
+// zeroDurationTrades returns the number of closed trades of the Strategy with a duration of 0
+func zeroDurationTrades(s backtest.Strategy) int {
+	n := 0
+	for _, t := range s.Trades {
+		if !t.IsOpen && t.TradeDuration <= 0 {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Register adds a report, reports are computed and shown in registration order
+func Register(d Definition) {
+	if _, ok := Lookup(d.Name); ok {
+		panic(fmt.Sprintf("report %s is already registered", d.Name))
+	}
+
+	definitions = append(definitions, d)
+}
+
+// Definitions returns the registered reports, in display order
+func Definitions() []Definition {
+	return slices.Clone(definitions)
+}
+
+// Lookup returns the registered report with the given name
+func Lookup(name string) (Definition, bool) {
+	for _, d := range definitions {
+		if d.Name == name {
+			return d, true
+		}
+	}
+
+	return Definition{}, false
+}
+
+// Names returns the names of the registered reports
+func Names() []string {
+	names := make([]string, 0, len(definitions))
+	for _, d := range definitions {
+		names = append(names, d.Name)
+	}
+
+	return names
+}
+
+// DefaultSelection returns the names of the reports shown when none is selected, every report but optional ones
+func DefaultSelection() []string {
+	var names []string
+	for _, d := range definitions {
+		if !d.Optional {
+			names = append(names, d.Name)
+		}
+	}
+
+	return names
+}
+
+// ParseSelection parses a comma separated list of report names, "all" selects every report.
+// It returns nil when value is empty.
+func ParseSelection(value string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "all" {
+			return Names(), nil
+		}
+		if _, ok := Lookup(name); !ok {
+			return nil, fmt.Errorf("unknown report %q, expecting one of %s", name, strings.Join(Names(), ", "))
+		}
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
+
+// selected returns whether the named report is selected, every report is selected when Reports is empty
+func (o Options) selected(name string) bool {
+	return len(o.Reports) == 0 || slices.Contains(o.Reports, name)
+}
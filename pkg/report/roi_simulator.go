@@ -0,0 +1,188 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// roiStep represents a minimal_roi step, trades exit once their profit
+// reaches Value after being open for Minutes
+type roiStep struct {
+	Minutes int
+	Value   float64
+}
+
+// ROI simulation categories
+const (
+	roiCategoryEarlier   = "exits earlier on roi"
+	roiCategoryRoi       = "roi exit"
+	roiCategoryLater     = "no roi exit, held longer"
+	roiCategoryUnchanged = "unchanged"
+)
+
+// ParseROITable parses an alternative minimal_roi table, given either as
+// "minutes:value" pairs separated by commas (e.g. "0:0.05,30:0.02,60:0"),
+// as a JSON object (e.g. {"0": 0.05, "30": 0.02}) or as a JSON file.
+func ParseROITable(value string) (map[string]float64, error) {
+	value = strings.TrimSpace(value)
+
+	var data []byte
+	if strings.HasPrefix(value, "{") {
+		data = []byte(value)
+	} else if content, err := os.ReadFile(value); err == nil {
+		data = content
+	}
+
+	table := make(map[string]float64)
+	if data != nil {
+		err := json.Unmarshal(data, &table)
+		if err != nil {
+			return nil, fmt.Errorf("invalid roi table: %w", err)
+		}
+	} else {
+		for _, pair := range strings.Split(value, ",") {
+			minutes, v, ok := strings.Cut(strings.TrimSpace(pair), ":")
+			if !ok {
+				return nil, fmt.Errorf("invalid roi step %q, expecting minutes:value", pair)
+			}
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid roi step %q: %w", pair, err)
+			}
+			table[minutes] = f
+		}
+	}
+
+	for minutes := range table {
+		if _, err := strconv.Atoi(minutes); err != nil {
+			return nil, fmt.Errorf("invalid roi step minutes %q", minutes)
+		}
+	}
+	if len(table) == 0 {
+		return nil, fmt.Errorf("empty roi table")
+	}
+
+	return table, nil
+}
+
+// roiSteps returns the steps of a minimal_roi table sorted by minutes
+func roiSteps(table map[string]float64) []roiStep {
+	var steps []roiStep
+	for k, v := range table {
+		minutes, err := strconv.Atoi(k)
+		if err != nil {
+			continue
+		}
+		steps = append(steps, roiStep{Minutes: minutes, Value: v})
+	}
+
+	sort.Slice(steps, func(i, j int) bool {
+		return steps[i].Minutes < steps[j].Minutes
+	})
+
+	return steps
+}
+
+// threshold returns the roi value active after the given minutes, +Inf when no step is active yet
+func threshold(steps []roiStep, minutes int) float64 {
+	value := math.Inf(1)
+	for _, step := range steps {
+		if step.Minutes > minutes {
+			break
+		}
+		value = step.Value
+	}
+
+	return value
+}
+
+// heldThreshold returns the roi value a trade held past the given minutes exits at: the active step,
+// or the first step to become active when none is yet, or mfe for an empty table.
+func heldThreshold(steps []roiStep, minutes int, mfe float64) float64 {
+	value := threshold(steps, minutes)
+	if !math.IsInf(value, 1) {
+		return value
+	}
+	if len(steps) > 0 {
+		return steps[0].Value
+	}
+
+	return mfe
+}
+
+// SimulateROI replays the closed trades against an alternative minimal_roi table.
+//
+// Candle data is not available, so each trade is replayed using its maximum favorable excursion:
+// any roi step active during the trade and lower than the excursion would have been reached,
+// the trade exits at one of those steps, which gives low and high estimates.
+// ROI exits which do not reach the new table are held longer, their outcome is bounded
+// by the stoploss and by the roi threshold active when the trade actually closed.
+func SimulateROI(s backtest.Strategy, table map[string]float64) ROISimulation {
+	steps := roiSteps(table)
+	simulation := ROISimulation{
+		MinimalROI: table,
+	}
+
+	categories := make(map[string]*ROISimulationCategory)
+	for _, name := range []string{roiCategoryEarlier, roiCategoryRoi, roiCategoryLater, roiCategoryUnchanged} {
+		simulation.Categories = append(simulation.Categories, ROISimulationCategory{Category: name})
+	}
+	for i := range simulation.Categories {
+		categories[simulation.Categories[i].Category] = &simulation.Categories[i]
+	}
+
+	for _, t := range s.Trades {
+		if t.IsOpen {
+			continue
+		}
+
+		// favorable excursion net of fees, comparable to profit ratios
+		mfe := t.MFE() - t.FeeRatio()
+
+		low, high := math.Inf(1), math.Inf(-1)
+		for _, step := range steps {
+			if step.Minutes > t.TradeDuration || step.Value > mfe {
+				continue
+			}
+			low = math.Min(low, step.Value)
+			high = math.Max(high, step.Value)
+		}
+
+		var category string
+		switch {
+		case !math.IsInf(low, 1) && t.ExitReason == "roi":
+			category = roiCategoryRoi
+		case !math.IsInf(low, 1):
+			category = roiCategoryEarlier
+		case t.ExitReason == "roi":
+			category = roiCategoryLater
+			low = math.Min(t.ProfitRatio, s.Stoploss)
+			high = math.Max(t.ProfitRatio, heldThreshold(steps, t.TradeDuration, mfe))
+		default:
+			category = roiCategoryUnchanged
+			low, high = t.ProfitRatio, t.ProfitRatio
+		}
+
+		c := categories[category]
+		c.Trades++
+		c.ActualProfit = c.ActualProfit + t.ProfitAbs
+		c.ProjectedLow = c.ProjectedLow + low*t.StakeAmount
+		c.ProjectedHigh = c.ProjectedHigh + high*t.StakeAmount
+	}
+
+	for _, c := range simulation.Categories {
+		simulation.Trades = simulation.Trades + c.Trades
+		simulation.ActualProfit = simulation.ActualProfit + c.ActualProfit
+		simulation.ProjectedLow = simulation.ProjectedLow + c.ProjectedLow
+		simulation.ProjectedHigh = simulation.ProjectedHigh + c.ProjectedHigh
+	}
+
+	return simulation
+}
@@ -0,0 +1,130 @@
+package report
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+func TestSimulateROI(t *testing.T) {
+	testCases := []struct {
+		name     string
+		table    map[string]float64
+		trade    backtest.Trade
+		category string
+		low      float64
+		high     float64
+	}{
+		{
+			name:     "roi exit before any step is active",
+			table:    map[string]float64{"30": 0.5},
+			trade:    backtest.Trade{ExitReason: "roi", TradeDuration: 10, OpenRate: 100, MaxRate: 103, ProfitRatio: 0.02, StakeAmount: 100},
+			category: roiCategoryLater,
+			low:      -0.1 * 100,
+			high:     0.5 * 100,
+		},
+		{
+			name:     "roi exit below the active step",
+			table:    map[string]float64{"0": 0.05},
+			trade:    backtest.Trade{ExitReason: "roi", TradeDuration: 10, OpenRate: 100, MaxRate: 103, ProfitRatio: 0.02, StakeAmount: 100},
+			category: roiCategoryLater,
+			low:      -0.1 * 100,
+			high:     0.05 * 100,
+		},
+		{
+			name:     "exits earlier on roi",
+			table:    map[string]float64{"0": 0.05, "10": 0.01},
+			trade:    backtest.Trade{ExitReason: "exit_signal", TradeDuration: 20, OpenRate: 100, MaxRate: 103, ProfitRatio: -0.01, StakeAmount: 100},
+			category: roiCategoryEarlier,
+			low:      0.01 * 100,
+			high:     0.01 * 100,
+		},
+		{
+			name:     "unchanged",
+			table:    map[string]float64{"0": 0.05},
+			trade:    backtest.Trade{ExitReason: "stop_loss", TradeDuration: 20, OpenRate: 100, MaxRate: 101, ProfitRatio: -0.1, StakeAmount: 100},
+			category: roiCategoryUnchanged,
+			low:      -0.1 * 100,
+			high:     -0.1 * 100,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := backtest.Strategy{Stoploss: -0.1, Trades: []backtest.Trade{tc.trade}}
+			simulation := SimulateROI(s, tc.table)
+
+			for _, c := range simulation.Categories {
+				if c.Category != tc.category {
+					if c.Trades != 0 {
+						t.Errorf("expected no trade in category %q, got %d", c.Category, c.Trades)
+					}
+					continue
+				}
+				if c.Trades != 1 {
+					t.Errorf("expected 1 trade in category %q, got %d", c.Category, c.Trades)
+				}
+			}
+
+			if math.Abs(simulation.ProjectedLow-tc.low) > 1e-9 {
+				t.Errorf("expected projected low %v, got %v", tc.low, simulation.ProjectedLow)
+			}
+			if math.Abs(simulation.ProjectedHigh-tc.high) > 1e-9 {
+				t.Errorf("expected projected high %v, got %v", tc.high, simulation.ProjectedHigh)
+			}
+
+			_, err := json.Marshal(simulation)
+			if err != nil {
+				t.Errorf("expected simulation to be encoded as JSON: %v", err)
+			}
+		})
+	}
+}
+
+func TestParseROITable(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "roi.json")
+	err := os.WriteFile(file, []byte(`{"0": 0.1, "60": 0.02}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		value    string
+		expected map[string]float64
+		err      bool
+	}{
+		{name: "minutes:value pairs", value: "0:0.1, 30:0.05,60:0", expected: map[string]float64{"0": 0.1, "30": 0.05, "60": 0}},
+		{name: "JSON", value: ` {"0": 0.1, "30": 0.05}`, expected: map[string]float64{"0": 0.1, "30": 0.05}},
+		{name: "file", value: file, expected: map[string]float64{"0": 0.1, "60": 0.02}},
+		{name: "missing value", value: "0:0.1,30", err: true},
+		{name: "invalid value", value: "0:ten", err: true},
+		{name: "invalid minutes", value: "now:0.1", err: true},
+		{name: "invalid JSON", value: `{"0": "0.1"}`, err: true},
+		{name: "empty JSON", value: `{}`, err: true},
+		{name: "empty", value: "", err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			table, err := ParseROITable(tc.value)
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected an error, got %v", table)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(table, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, table)
+			}
+		})
+	}
+}
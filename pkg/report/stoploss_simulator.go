@@ -0,0 +1,158 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// TrailingStop holds trailing stop parameters, the trailing stop follows the highest profit
+// at a Positive distance once the profit reached Offset.
+type TrailingStop struct {
+	Positive float64 `json:"trailing_stop_positive"`
+	Offset   float64 `json:"trailing_stop_positive_offset"`
+}
+
+// ParseStoplossSweep parses stoploss values, given either as a comma separated list
+// (e.g. "-0.02,-0.05,-0.1") or as a start:end:step range (e.g. "-0.3:-0.02:0.02").
+func ParseStoplossSweep(value string) ([]float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if parts := strings.Split(value, ":"); len(parts) == 3 {
+		var bounds [3]float64
+		for i, p := range parts {
+			f, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid stoploss range %q: %w", value, err)
+			}
+			bounds[i] = f
+		}
+
+		start, end, step := bounds[0], bounds[1], math.Abs(bounds[2])
+		if step == 0 {
+			return nil, fmt.Errorf("invalid stoploss range %q: step must not be 0", value)
+		}
+		if start > end {
+			start, end = end, start
+		}
+
+		var values []float64
+		// small epsilon so the end value is included despite rounding errors
+		for v := start; v <= end+step/1e6; v = v + step {
+			values = append(values, math.Round(v*1e6)/1e6)
+		}
+		return values, nil
+	}
+
+	var values []float64
+	for _, p := range strings.Split(value, ",") {
+		f, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stoploss %q: %w", p, err)
+		}
+		values = append(values, f)
+	}
+
+	return values, nil
+}
+
+// ParseTrailingStop parses trailing stop parameters given as "positive:offset", e.g. "0.01:0.02"
+func ParseTrailingStop(value string) (*TrailingStop, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	positive, offset, _ := strings.Cut(value, ":")
+	var ts TrailingStop
+	var err error
+	ts.Positive, err = strconv.ParseFloat(strings.TrimSpace(positive), 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trailing stop %q: %w", value, err)
+	}
+	if offset != "" {
+		ts.Offset, err = strconv.ParseFloat(strings.TrimSpace(offset), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trailing stop %q: %w", value, err)
+		}
+	}
+
+	return &ts, nil
+}
+
+// stopTolerance absorbs rounding errors when comparing excursions and stoploss ratios
+const stopTolerance = 1e-9
+
+// SimulateStoploss estimates how each stoploss value, and optional trailing stop,
+// would have changed the outcome of the closed trades.
+//
+// Trades with an adverse excursion reaching the stoploss are stopped out at the stoploss.
+// Trades whose adverse excursion reached their initial stoploss were actually stopped,
+// when the new stoploss is wider they are saved, their outcome is unknown and estimated
+// in the worst case, running down to the new stoploss.
+// With a trailing stop, trades whose favorable excursion reached the offset exit at their
+// highest profit minus the trailing distance, which may be worse than their actual outcome,
+// and never below the stoploss.
+// The order in which lowest and highest prices were reached is unknown, the stoploss wins
+// when both would trigger.
+func SimulateStoploss(s backtest.Strategy, stoplosses []float64, trailing *TrailingStop) StoplossSimulation {
+	simulation := StoplossSimulation{
+		Stoploss: s.Stoploss,
+		Trailing: trailing,
+	}
+
+	for _, sl := range stoplosses {
+		result := StoplossSimulationResult{
+			Stoploss: sl,
+		}
+
+		for _, t := range s.Trades {
+			if t.IsOpen {
+				continue
+			}
+
+			result.ActualProfit = result.ActualProfit + t.ProfitAbs
+			projected := t.ProfitAbs
+			stopProfit := (sl - t.FeeRatio()) * t.StakeAmount
+			initialStop := t.InitialStoploss(s.Stoploss)
+			stopped := t.MAE() <= initialStop+stopTolerance
+
+			switch {
+			case t.MAE() <= sl+stopTolerance:
+				projected = stopProfit
+				switch {
+				case !stopped:
+					result.StoppedOut++
+					result.StoppedOutImpact = result.StoppedOutImpact + projected - t.ProfitAbs
+				case math.Abs(sl-initialStop) > stopTolerance:
+					// already stopped out, exiting earlier at the tighter stoploss
+					result.Tightened++
+					result.TightenedImpact = result.TightenedImpact + projected - t.ProfitAbs
+				}
+			case stopped:
+				projected = stopProfit
+				result.Saved++
+				result.SavedImpact = result.SavedImpact + projected - t.ProfitAbs
+			case trailing != nil:
+				mfe := t.MFE()
+				if mfe < trailing.Offset {
+					break
+				}
+				projected = (math.Max(mfe-trailing.Positive, sl) - t.FeeRatio()) * t.StakeAmount
+				result.TrailingExits++
+				result.TrailingImpact = result.TrailingImpact + projected - t.ProfitAbs
+			}
+
+			result.ProjectedProfit = result.ProjectedProfit + projected
+		}
+
+		result.NetImpact = result.ProjectedProfit - result.ActualProfit
+		simulation.Results = append(simulation.Results, result)
+	}
+
+	return simulation
+}
@@ -0,0 +1,148 @@
+package report
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+func TestSimulateStoploss(t *testing.T) {
+	testCases := []struct {
+		name       string
+		stoploss   float64
+		trailing   *TrailingStop
+		trade      backtest.Trade
+		stoppedOut int
+		tightened  int
+		saved      int
+		trailingEx int
+		projected  float64
+	}{
+		{
+			name:       "stopped out by a tighter stoploss",
+			stoploss:   -0.05,
+			trade:      backtest.Trade{ExitReason: "exit_signal", OpenRate: 100, MinRate: 93, MaxRate: 101, ProfitRatio: 0.01, ProfitAbs: 1, StakeAmount: 100, InitialStopLossRatio: -0.1},
+			stoppedOut: 1,
+			projected:  -5,
+		},
+		{
+			name:      "already stopped out by a wider initial stoploss",
+			stoploss:  -0.05,
+			trade:     backtest.Trade{ExitReason: "stop_loss", OpenRate: 100, MinRate: 90, MaxRate: 101, ProfitRatio: -0.1, ProfitAbs: -10, StakeAmount: 100, InitialStopLossRatio: -0.1},
+			tightened: 1,
+			projected: -5,
+		},
+		{
+			name:      "same stoploss as the initial one",
+			stoploss:  -0.1,
+			trade:     backtest.Trade{ExitReason: "stop_loss", OpenRate: 100, MinRate: 90, MaxRate: 101, ProfitRatio: -0.1, ProfitAbs: -10, StakeAmount: 100, InitialStopLossRatio: -0.1},
+			projected: -10,
+		},
+		{
+			name:      "saved by a wider stoploss",
+			stoploss:  -0.2,
+			trade:     backtest.Trade{ExitReason: "stop_loss", OpenRate: 100, MinRate: 90, MaxRate: 101, ProfitRatio: -0.1, ProfitAbs: -10, StakeAmount: 100, InitialStopLossRatio: -0.1},
+			saved:     1,
+			projected: -20,
+		},
+		{
+			name:      "initial stoploss reached with another exit reason",
+			stoploss:  -0.2,
+			trade:     backtest.Trade{ExitReason: "stoploss_on_exchange", OpenRate: 100, MinRate: 92, MaxRate: 101, ProfitRatio: -0.08, ProfitAbs: -8, StakeAmount: 100, InitialStopLossRatio: -0.08},
+			saved:     1,
+			projected: -20,
+		},
+		{
+			name:      "trailing stop loss exit not reaching the initial stoploss",
+			stoploss:  -0.2,
+			trade:     backtest.Trade{ExitReason: "trailing_stop_loss", OpenRate: 100, MinRate: 97, MaxRate: 101, ProfitRatio: -0.03, ProfitAbs: -3, StakeAmount: 100, InitialStopLossRatio: -0.1},
+			projected: -3,
+		},
+		{
+			name:       "trailing exit better than the actual exit",
+			stoploss:   -0.1,
+			trailing:   &TrailingStop{Positive: 0.01, Offset: 0.02},
+			trade:      backtest.Trade{ExitReason: "exit_signal", OpenRate: 100, MinRate: 99, MaxRate: 105, ProfitRatio: 0.01, ProfitAbs: 1, StakeAmount: 100, InitialStopLossRatio: -0.1},
+			trailingEx: 1,
+			projected:  4,
+		},
+		{
+			name:       "trailing exit worse than the actual exit",
+			stoploss:   -0.1,
+			trailing:   &TrailingStop{Positive: 0.02, Offset: 0.02},
+			trade:      backtest.Trade{ExitReason: "roi", OpenRate: 100, MinRate: 99, MaxRate: 105, ProfitRatio: 0.05, ProfitAbs: 5, StakeAmount: 100, InitialStopLossRatio: -0.1},
+			trailingEx: 1,
+			projected:  3,
+		},
+		{
+			name:      "trailing offset not reached",
+			stoploss:  -0.1,
+			trailing:  &TrailingStop{Positive: 0.01, Offset: 0.1},
+			trade:     backtest.Trade{ExitReason: "roi", OpenRate: 100, MinRate: 99, MaxRate: 105, ProfitRatio: 0.05, ProfitAbs: 5, StakeAmount: 100, InitialStopLossRatio: -0.1},
+			projected: 5,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := backtest.Strategy{Stoploss: -0.1, Trades: []backtest.Trade{tc.trade}}
+			simulation := SimulateStoploss(s, []float64{tc.stoploss}, tc.trailing)
+
+			result := simulation.Results[0]
+			if result.StoppedOut != tc.stoppedOut {
+				t.Errorf("expected %d stopped out, got %d", tc.stoppedOut, result.StoppedOut)
+			}
+			if result.Tightened != tc.tightened {
+				t.Errorf("expected %d tightened, got %d", tc.tightened, result.Tightened)
+			}
+			if result.Saved != tc.saved {
+				t.Errorf("expected %d saved, got %d", tc.saved, result.Saved)
+			}
+			if result.TrailingExits != tc.trailingEx {
+				t.Errorf("expected %d trailing exits, got %d", tc.trailingEx, result.TrailingExits)
+			}
+			if math.Abs(result.ProjectedProfit-tc.projected) > 1e-9 {
+				t.Errorf("expected projected profit %v, got %v", tc.projected, result.ProjectedProfit)
+			}
+		})
+	}
+}
+
+func TestParseStoplossSweep(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected []float64
+		err      bool
+	}{
+		{name: "empty", value: ""},
+		{name: "single value", value: "-0.1", expected: []float64{-0.1}},
+		{name: "list", value: "-0.05, -0.1,-0.2", expected: []float64{-0.05, -0.1, -0.2}},
+		{name: "range", value: "-0.1:-0.05:0.01", expected: []float64{-0.1, -0.09, -0.08, -0.07, -0.06, -0.05}},
+		{name: "reversed range", value: "-0.05:-0.1:-0.025", expected: []float64{-0.1, -0.075, -0.05}},
+		{name: "range not reaching its end", value: "-0.3:-0.1:0.15", expected: []float64{-0.3, -0.15}},
+		{name: "zero step", value: "-0.1:-0.05:0", err: true},
+		{name: "invalid range", value: "-0.1:x:0.01", err: true},
+		{name: "invalid value", value: "-0.1,x", err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			values, err := ParseStoplossSweep(tc.value)
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected an error, got %v", values)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(values, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, values)
+			}
+		})
+	}
+}
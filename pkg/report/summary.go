@@ -0,0 +1,53 @@
+package report
+
+import (
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// StrategySummary represents a strategy in the summary of a backtest result,
+// like freqtrade's STRATEGY SUMMARY block.
+type StrategySummary struct {
+	Strategy           string   `json:"strategy"`
+	Trades             int      `json:"trades"`
+	ProfitMean         float64  `json:"profit_mean"`
+	ProfitTotalAbs     float64  `json:"profit_total_abs"`
+	ProfitTotal        float64  `json:"profit_total"`
+	HoldingAvgDuration float64  `json:"holding_avg_s"`
+	Wins               int      `json:"wins"`
+	Draws              int      `json:"draws"`
+	Losses             int      `json:"losses"`
+	WinRate            float64  `json:"winrate"`
+	DrawdownAbs        float64  `json:"max_drawdown_abs"`
+	DrawdownAbsAccount float64  `json:"max_drawdown_account"`
+	StakeCurrency      string   `json:"stake_currency"`
+	Score              *float64 `json:"score"`
+	// CrossCheck is freqtrade strategy comparison row checked against the recomputed trades
+	CrossCheck *CrossCheckRow `json:"freqtrade,omitempty"`
+}
+
+// Summary returns a StrategySummary for every strategy report of the BacktestResult, in the order of reports
+func Summary(br *backtest.BacktestResult, reports []StrategyReport) []StrategySummary {
+	summaries := make([]StrategySummary, 0, len(reports))
+	for _, r := range reports {
+		s := br.Strategy[r.Strategy]
+		summaries = append(summaries, StrategySummary{
+			Strategy:           r.Strategy,
+			Trades:             s.TotalTrades,
+			ProfitMean:         s.ProfitMean,
+			ProfitTotalAbs:     s.ProfitTotalAbs,
+			ProfitTotal:        s.ProfitTotal,
+			HoldingAvgDuration: s.HoldingAvgDuration,
+			Wins:               s.Wins,
+			Draws:              s.Draws,
+			Losses:             s.Losses,
+			WinRate:            s.WinRate(),
+			DrawdownAbs:        s.DrawdownAbs,
+			DrawdownAbsAccount: s.DrawdownAbsAccount,
+			StakeCurrency:      s.StakeCurrency,
+			Score:              r.Score,
+			CrossCheck:         StrategyComparisonCheck(br, r.Strategy),
+		})
+	}
+
+	return summaries
+}
@@ -0,0 +1,86 @@
+package report
+
+import (
+	"sort"
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// TradeRecord represents a trade along with its derived metrics, as exported by the export command.
+// Ratios are not converted to percentages.
+type TradeRecord struct {
+	Strategy         string    `json:"strategy"`
+	Pair             string    `json:"pair"`
+	EnterTag         string    `json:"enter_tag"`
+	ExitReason       string    `json:"exit_reason"`
+	ExitReasonDetail string    `json:"exit_reason_detail"`
+	IsShort          bool      `json:"is_short"`
+	IsOpen           bool      `json:"is_open"`
+	OpenDate         time.Time `json:"open_date"`
+	CloseDate        time.Time `json:"close_date"`
+	DurationMinutes  int       `json:"duration_m"`
+	Leverage         float64   `json:"leverage"`
+	OpenRate         float64   `json:"open_rate"`
+	CloseRate        float64   `json:"close_rate"`
+	StakeAmount      float64   `json:"stake_amount"`
+	ProfitAbs        float64   `json:"profit_abs"`
+	ProfitRatio      float64   `json:"profit_ratio"`
+	MAE              float64   `json:"mae"`
+	MFE              float64   `json:"mfe"`
+	LeftOnTable      float64   `json:"left_on_table"`
+}
+
+// TradeRecordColumns are the column names of a TradeRecord, in the order of TradeRecord.Values
+var TradeRecordColumns = []string{
+	"strategy", "pair", "enter_tag", "exit_reason", "exit_reason_detail", "is_short", "is_open",
+	"open_date", "close_date", "duration_m", "leverage", "open_rate", "close_rate", "stake_amount",
+	"profit_abs", "profit_ratio", "mae", "mfe", "left_on_table",
+}
+
+// TradeRecords returns a TradeRecord for every trade of the named Strategy, sorted by open date.
+// ROI exits are detailed with the roi step they reached.
+func TradeRecords(name string, s backtest.Strategy) []TradeRecord {
+	s.SortMinimalROI()
+
+	records := make([]TradeRecord, 0, len(s.Trades))
+	for _, t := range s.Trades {
+		reasons := GetExitReasons(s, t)
+		records = append(records, TradeRecord{
+			Strategy:         name,
+			Pair:             t.Pair,
+			EnterTag:         t.GetEnterTag(),
+			ExitReason:       t.ExitReason,
+			ExitReasonDetail: reasons[len(reasons)-1],
+			IsShort:          t.IsShort,
+			IsOpen:           t.IsOpen,
+			OpenDate:         t.OpenDate.Time,
+			CloseDate:        t.CloseDate.Time,
+			DurationMinutes:  t.TradeDuration,
+			Leverage:         t.EffectiveLeverage(),
+			OpenRate:         t.OpenRate,
+			CloseRate:        t.CloseRate,
+			StakeAmount:      t.StakeAmount,
+			ProfitAbs:        t.ProfitAbs,
+			ProfitRatio:      t.ProfitRatio,
+			MAE:              t.MAE(),
+			MFE:              t.MFE(),
+			LeftOnTable:      t.LeftOnTable(),
+		})
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].OpenDate.Before(records[j].OpenDate)
+	})
+
+	return records
+}
+
+// Values returns the values of the TradeRecord, in the order of TradeRecordColumns
+func (tr TradeRecord) Values() []interface{} {
+	return []interface{}{
+		tr.Strategy, tr.Pair, tr.EnterTag, tr.ExitReason, tr.ExitReasonDetail, tr.IsShort, tr.IsOpen,
+		tr.OpenDate, tr.CloseDate, tr.DurationMinutes, tr.Leverage, tr.OpenRate, tr.CloseRate, tr.StakeAmount,
+		tr.ProfitAbs, tr.ProfitRatio, tr.MAE, tr.MFE, tr.LeftOnTable,
+	}
+}
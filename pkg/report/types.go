@@ -0,0 +1,301 @@
+// Package report computes reports from freqtrade backtest results: exit reasons, pairs,
+// enter tags, periodic breakdowns, drawdowns, what-if simulations and resampling statistics.
+package report
+
+import (
+	"time"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/score"
+)
+
+// Options holds the options used to compute reports
+type Options struct {
+	// Breakdowns are the periods used for the periodic breakdown reports, e.g. "day", "month"
+	Breakdowns []string
+	// ROITable is an alternative minimal_roi table to simulate, nil to skip the simulation
+	ROITable map[string]float64
+	// StoplossSweep are the stoploss values to simulate
+	StoplossSweep []float64
+	// TrailingStop are the trailing stop parameters to simulate, nil to skip trailing stop simulation
+	TrailingStop *TrailingStop
+	// MonteCarlo holds the Monte Carlo simulation parameters, no simulation is run when Simulations is 0
+	MonteCarlo MonteCarloOptions
+	// Bootstrap holds the bootstrap parameters, no confidence interval is computed when Samples is 0
+	Bootstrap BootstrapOptions
+	// ScoreModel is the model used to score strategies, score.Default is used when nil
+	ScoreModel *score.Model
+	// Reports are the names of the reports to compute, every registered report is computed when empty
+	Reports []string
+}
+
+// scoreModel returns the scoring model of the Options
+func (o Options) scoreModel() score.Model {
+	if o.ScoreModel == nil {
+		return score.Default
+	}
+
+	return *o.ScoreModel
+}
+
+// StrategyReport represents the computed reports of a strategy
+type StrategyReport struct {
+	Strategy           string               `json:"strategy"`
+	Metrics            StrategyMetrics      `json:"metrics"`
+	Score              *float64             `json:"score"`
+	ScoreBreakdown     score.Breakdown      `json:"score_breakdown"`
+	ExitReasonReports  ExitReasonReports    `json:"exit_reasons"`
+	PairReports        PairReports          `json:"pairs"`
+	EnterTagReports    ExitReasonReports    `json:"enter_tags"`
+	BreakdownReports   []BreakdownReport    `json:"breakdowns"`
+	DrawdownReport     DrawdownReport       `json:"drawdowns"`
+	ROISimulation      *ROISimulation       `json:"roi_simulation,omitempty"`
+	StoplossSimulation *StoplossSimulation  `json:"stoploss_simulation,omitempty"`
+	LeftOnTable        []DistributionBucket `json:"left_on_table"`
+	MonteCarlo         *MonteCarloReport    `json:"montecarlo,omitempty"`
+	Bootstrap          *BootstrapReport     `json:"bootstrap,omitempty"`
+	CrossCheck         *CrossCheck          `json:"freqtrade,omitempty"`
+	// Warnings are raised while computing the reports, e.g. when recomputed values differ from freqtrade
+	Warnings []string `json:"warnings"`
+}
+
+// StrategyMetrics represents the general metrics of a strategy as reported by freqtrade
+type StrategyMetrics struct {
+	BacktestStart      time.Time          `json:"backtest_start"`
+	BacktestEnd        time.Time          `json:"backtest_end"`
+	BacktestDays       int                `json:"backtest_days"`
+	MaxOpenTrades      int                `json:"max_open_trades"`
+	MinimalROI         map[string]float64 `json:"minimal_roi"`
+	Stoploss           float64            `json:"stoploss"`
+	StakeCurrency      string             `json:"stake_currency"`
+	TotalTrades        int                `json:"total_trades"`
+	StartingBalance    float64            `json:"starting_balance"`
+	FinalBalance       float64            `json:"final_balance"`
+	ProfitTotalAbs     float64            `json:"profit_total_abs"`
+	ProfitTotal        float64            `json:"profit_total"`
+	ProfitMean         float64            `json:"profit_mean"`
+	ProfitFactor       float64            `json:"profit_factor"`
+	CAGR               float64            `json:"cagr"`
+	Sortino            float64            `json:"sortino"`
+	Sharpe             float64            `json:"sharpe"`
+	Calmar             float64            `json:"calmar"`
+	Expectancy         float64            `json:"expectancy"`
+	TradesPerDay       float64            `json:"trades_per_day"`
+	AvgStakeAmount     float64            `json:"avg_stake_amount"`
+	TotalVolume        float64            `json:"total_volume"`
+	TradeCountLong     int                `json:"trade_count_long"`
+	TradeCountShort    int                `json:"trade_count_short"`
+	ProfitTotalLong    float64            `json:"profit_total_long"`
+	ProfitTotalShort   float64            `json:"profit_total_short"`
+	Wins               int                `json:"wins"`
+	Draws              int                `json:"draws"`
+	Losses             int                `json:"losses"`
+	DrawdownRelative   float64            `json:"max_relative_drawdown"`
+	DrawdownAbsAccount float64            `json:"max_drawdown_account"`
+	DrawdownAbs        float64            `json:"max_drawdown_abs"`
+	DrawdownStart      time.Time          `json:"drawdown_start"`
+	DrawdownEnd        time.Time          `json:"drawdown_end"`
+	MarketChange       float64            `json:"market_change"`
+}
+
+// ExitReasonReports is a list of ExitReasonReport
+type ExitReasonReports []ExitReasonReport
+
+// ExitReasonReport represents the performance of trades grouped by exit reason,
+// nested reports break the reason down further, e.g. by roi step.
+type ExitReasonReport struct {
+	Reason                string            `json:"reason"`
+	Exits                 int               `json:"exits"`
+	ProfitAbs             []float64         `json:"-"`
+	TradeDurations        []int             `json:"-"`
+	AvgDuration           int               `json:"avg_duration_m"`
+	StdDevDuration        float64           `json:"stddev_duration_m"`
+	AvgProfit             float64           `json:"avg_profit_abs"`
+	TotalProfit           float64           `json:"total_profit_abs"`
+	TotalProfitPercentage float64           `json:"total_profit_pct"`
+	MAE                   []float64         `json:"-"`
+	MFE                   []float64         `json:"-"`
+	LeftOnTable           []float64         `json:"-"`
+	AvgMAE                float64           `json:"avg_mae_pct"`
+	WorstMAE              float64           `json:"worst_mae_pct"`
+	AvgMFE                float64           `json:"avg_mfe_pct"`
+	BestMFE               float64           `json:"best_mfe_pct"`
+	AvgLeftOnTable        float64           `json:"avg_left_on_table_pct"`
+	MedianLeftOnTable     float64           `json:"median_left_on_table_pct"`
+	P90LeftOnTable        float64           `json:"p90_left_on_table_pct"`
+	ProfitDistribution    Distribution      `json:"profit_abs_distribution"`
+	DurationDistribution  Distribution      `json:"duration_m_distribution"`
+	ExitReasonReports     ExitReasonReports `json:"children,omitempty"`
+}
+
+// PairReports is a list of PairReport
+type PairReports []PairReport
+
+// PairReport represents the performance of a single pair
+type PairReport struct {
+	Pair                  string  `json:"pair"`
+	Trades                int     `json:"trades"`
+	Wins                  int     `json:"wins"`
+	Draws                 int     `json:"draws"`
+	Losses                int     `json:"losses"`
+	AvgProfit             float64 `json:"avg_profit_pct"`
+	TotalProfit           float64 `json:"total_profit_abs"`
+	TotalProfitPercentage float64 `json:"total_profit_pct"`
+	WinRate               float64 `json:"win_rate_pct"`
+	AvgDuration           int     `json:"avg_duration_m"`
+	WorstTrade            float64 `json:"worst_trade_pct"`
+	DrawdownContribution  float64 `json:"drawdown_contribution_pct"`
+}
+
+// BreakdownReport represents the profit of a strategy broken down by period
+type BreakdownReport struct {
+	Period  string         `json:"period"`
+	Periods []PeriodReport `json:"periods"`
+}
+
+// PeriodReport represents the profit of a single period
+type PeriodReport struct {
+	Name      string    `json:"name"`
+	Start     time.Time `json:"start"`
+	Trades    int       `json:"trades"`
+	Wins      int       `json:"wins"`
+	Draws     int       `json:"draws"`
+	Losses    int       `json:"losses"`
+	ProfitAbs float64   `json:"profit_abs"`
+	Profit    float64   `json:"profit_ratio"`
+	Balance   float64   `json:"balance"`
+}
+
+// EquityPoint represents the balance at a given date
+type EquityPoint struct {
+	Date    time.Time
+	Balance float64
+}
+
+// DrawdownReport represents the drawdown episodes of a strategy,
+// warnings are encoded along with the StrategyReport warnings.
+type DrawdownReport struct {
+	Episodes            []DrawdownEpisode `json:"episodes"`
+	MaxDrawdownAbs      float64           `json:"max_drawdown_abs"`
+	MaxDrawdownRelative float64           `json:"max_drawdown_ratio"`
+	Warnings            []string          `json:"-"`
+}
+
+// DrawdownEpisode represents a single drawdown, from a balance high until its recovery,
+// or until the last trade when it did not recover.
+type DrawdownEpisode struct {
+	Start         time.Time     `json:"start"`
+	Trough        time.Time     `json:"trough"`
+	Recovery      time.Time     `json:"recovery"`
+	Recovered     bool          `json:"recovered"`
+	High          float64       `json:"high"`
+	Low           float64       `json:"low"`
+	Depth         float64       `json:"depth_abs"`
+	DepthRelative float64       `json:"depth_ratio"`
+	Duration      time.Duration `json:"duration_s"`
+	TimeToRecover time.Duration `json:"time_to_recover_s"`
+}
+
+// ROISimulation represents the projected outcome of trades using an alternative minimal_roi table
+type ROISimulation struct {
+	MinimalROI    map[string]float64      `json:"minimal_roi"`
+	Trades        int                     `json:"trades"`
+	ActualProfit  float64                 `json:"actual_profit_abs"`
+	ProjectedLow  float64                 `json:"projected_profit_low_abs"`
+	ProjectedHigh float64                 `json:"projected_profit_high_abs"`
+	Categories    []ROISimulationCategory `json:"categories"`
+}
+
+// ROISimulationCategory represents the trades affected the same way by the alternative minimal_roi table
+type ROISimulationCategory struct {
+	Category      string  `json:"category"`
+	Trades        int     `json:"trades"`
+	ActualProfit  float64 `json:"actual_profit_abs"`
+	ProjectedLow  float64 `json:"projected_profit_low_abs"`
+	ProjectedHigh float64 `json:"projected_profit_high_abs"`
+}
+
+// StoplossSimulation represents the projected outcome of trades across stoploss values
+type StoplossSimulation struct {
+	Stoploss float64                    `json:"stoploss"`
+	Trailing *TrailingStop              `json:"trailing_stop"`
+	Results  []StoplossSimulationResult `json:"results"`
+}
+
+// StoplossSimulationResult represents the projected outcome of trades for a single stoploss value
+type StoplossSimulationResult struct {
+	Stoploss         float64 `json:"stoploss"`
+	StoppedOut       int     `json:"stopped_out"`
+	Tightened        int     `json:"tightened"`
+	Saved            int     `json:"saved"`
+	TrailingExits    int     `json:"trailing_exits"`
+	StoppedOutImpact float64 `json:"stopped_out_impact_abs"`
+	TightenedImpact  float64 `json:"tightened_impact_abs"`
+	SavedImpact      float64 `json:"saved_impact_abs"`
+	TrailingImpact   float64 `json:"trailing_impact_abs"`
+	NetImpact        float64 `json:"net_impact_abs"`
+	ActualProfit     float64 `json:"actual_profit_abs"`
+	ProjectedProfit  float64 `json:"projected_profit_abs"`
+}
+
+// DistributionBucket represents the number of values within [Low, High)
+type DistributionBucket struct {
+	Low   float64 `json:"low"`
+	High  float64 `json:"high"`
+	Count int     `json:"count"`
+}
+
+// Distribution represents the percentiles and shape of a set of values
+type Distribution struct {
+	Min      float64 `json:"min"`
+	P5       float64 `json:"p5"`
+	P25      float64 `json:"p25"`
+	Median   float64 `json:"median"`
+	P75      float64 `json:"p75"`
+	P95      float64 `json:"p95"`
+	Max      float64 `json:"max"`
+	Skew     float64 `json:"skew"`
+	Kurtosis float64 `json:"kurtosis"`
+}
+
+// MonteCarloReport represents the outcome distributions of Monte Carlo simulations,
+// confidence intervals are read from the distribution percentiles.
+type MonteCarloReport struct {
+	Simulations         int               `json:"simulations"`
+	Mode                string            `json:"mode"`
+	Seed                uint64            `json:"seed"`
+	Actual              MonteCarloOutcome `json:"actual"`
+	FinalBalance        Distribution      `json:"final_balance"`
+	MaxDrawdownAbs      Distribution      `json:"max_drawdown_abs"`
+	MaxDrawdownRelative Distribution      `json:"max_drawdown_ratio"`
+	LongestLosingStreak Distribution      `json:"longest_losing_streak"`
+	LossProbability     float64           `json:"loss_probability"`
+}
+
+// MonteCarloOutcome represents the outcome of a single trade sequence
+type MonteCarloOutcome struct {
+	FinalBalance        float64 `json:"final_balance"`
+	MaxDrawdownAbs      float64 `json:"max_drawdown_abs"`
+	MaxDrawdownRelative float64 `json:"max_drawdown_ratio"`
+	LongestLosingStreak int     `json:"longest_losing_streak"`
+}
+
+// BootstrapReport represents bootstrap confidence intervals of strategy metrics
+type BootstrapReport struct {
+	Samples    int                 `json:"samples"`
+	Confidence float64             `json:"confidence"`
+	Seed       uint64              `json:"seed"`
+	Intervals  []BootstrapInterval `json:"intervals"`
+}
+
+// BootstrapInterval represents the confidence interval of a metric,
+// Actual is the freqtrade value and Recomputed the value computed from the trade list.
+type BootstrapInterval struct {
+	Metric     string  `json:"metric"`
+	Samples    int     `json:"samples"`
+	Actual     float64 `json:"-"`
+	Recomputed float64 `json:"-"`
+	Mean       float64 `json:"-"`
+	Low        float64 `json:"-"`
+	Median     float64 `json:"-"`
+	High       float64 `json:"-"`
+}
@@ -0,0 +1,264 @@
+// Package score scores freqtrade strategies using configurable scoring models.
+package score
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/internal/jsonfloat"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed default_score.yaml
+var defaultModelData []byte
+
+// Default is the built-in scoring model
+var Default = mustParse(defaultModelData)
+
+// ruleOperators maps the supported rule operators to their comparison function
+var ruleOperators = map[string]func(a, b float64) bool{
+	"<":  func(a, b float64) bool { return a < b },
+	"<=": func(a, b float64) bool { return a <= b },
+	">":  func(a, b float64) bool { return a > b },
+	">=": func(a, b float64) bool { return a >= b },
+	"==": func(a, b float64) bool { return a == b },
+	"!=": func(a, b float64) bool { return a != b },
+}
+
+// DefaultFailScore is the score of strategies matching a hard-fail rule when the model sets none
+const DefaultFailScore = -1
+
+// Model defines how strategies are scored
+type Model struct {
+	Metrics []Metric `yaml:"metrics"`
+	Rules   []Rule   `yaml:"rules"`
+	// FailScore is the score of strategies matching a hard-fail rule, DefaultFailScore when nil
+	FailScore *float64 `yaml:"fail_score"`
+}
+
+// Metric defines how a single metric contributes to the score
+type Metric struct {
+	Name        string  `yaml:"name"`
+	Metric      string  `yaml:"metric"`
+	Scale       float64 `yaml:"scale"`
+	Baseline    float64 `yaml:"baseline"`
+	Sensitivity float64 `yaml:"sensitivity"`
+	Weight      float64 `yaml:"weight"`
+	// Direction is either "higher" (default) when higher values are better or "lower"
+	Direction string `yaml:"direction"`
+}
+
+// Rule is a hard-fail rule, strategies matching it get the model FailScore
+type Rule struct {
+	Metric   string  `yaml:"metric"`
+	Operator string  `yaml:"operator"`
+	Value    float64 `yaml:"value"`
+}
+
+// String returns a string representation of the Rule, e.g. "profit_factor < 1"
+func (r Rule) String() string {
+	return fmt.Sprintf("%s %s %g", r.Metric, r.Operator, r.Value)
+}
+
+// Breakdown explains how the score of a strategy was computed
+type Breakdown struct {
+	Metrics    []MetricScore `json:"metrics"`
+	FailedRule string        `json:"failed_rule,omitempty"`
+	Score      float64       `json:"score"`
+}
+
+// MetricScore represents the contribution of a single metric to the score
+type MetricScore struct {
+	Name         string  `json:"name"`
+	Metric       string  `json:"metric"`
+	Value        float64 `json:"value"`
+	Baseline     float64 `json:"baseline"`
+	Direction    string  `json:"direction"`
+	Sensitivity  float64 `json:"sensitivity"`
+	Score        float64 `json:"score"`
+	Weight       float64 `json:"weight"`
+	Contribution float64 `json:"contribution"`
+}
+
+// Score computes the score of a strategy as the weighted sum of its metric scores,
+// strategies matching any of the hard-fail rules get FailScore.
+func (m Model) Score(s backtest.Strategy) float64 {
+	return m.Breakdown(s).Score
+}
+
+// Breakdown computes the score of a strategy and explains how it was computed,
+// with the contribution of each metric and the hard-fail rule that matched if any.
+func (m Model) Breakdown(s backtest.Strategy) Breakdown {
+	var breakdown Breakdown
+
+	var totalScore float64
+	for _, sm := range m.Metrics {
+		value, _ := s.Metric(sm.Metric)
+		value = value * sm.Scale
+		score := metric_score(value, sm.Baseline, sm.Direction != "lower", sm.Sensitivity)
+		totalScore = totalScore + sm.Weight*score
+
+		breakdown.Metrics = append(breakdown.Metrics, MetricScore{
+			Name:         sm.Name,
+			Metric:       sm.Metric,
+			Value:        value,
+			Baseline:     sm.Baseline,
+			Direction:    sm.Direction,
+			Sensitivity:  sm.Sensitivity,
+			Score:        score,
+			Weight:       sm.Weight,
+			Contribution: sm.Weight * score,
+		})
+	}
+	breakdown.Score = totalScore
+
+	// handle extreme values
+	for _, r := range m.Rules {
+		value, _ := s.Metric(r.Metric)
+		if ruleOperators[r.Operator](value, r.Value) {
+			breakdown.FailedRule = r.String()
+			breakdown.Score = m.failScore()
+			break
+		}
+	}
+
+	return breakdown
+}
+
+// failScore returns the score of strategies matching a hard-fail rule
+func (m Model) failScore() float64 {
+	if m.FailScore == nil {
+		return DefaultFailScore
+	}
+
+	return *m.FailScore
+}
+
+// MarshalJSON encodes NaN and infinite scores as null
+func (ms MetricScore) MarshalJSON() ([]byte, error) {
+	type metricScore MetricScore
+
+	return json.Marshal(struct {
+		metricScore
+		Value        *float64 `json:"value"`
+		Score        *float64 `json:"score"`
+		Contribution *float64 `json:"contribution"`
+	}{
+		metricScore:  metricScore(ms),
+		Value:        jsonfloat.Finite(ms.Value),
+		Score:        jsonfloat.Finite(ms.Score),
+		Contribution: jsonfloat.Finite(ms.Contribution),
+	})
+}
+
+// MarshalJSON encodes NaN and infinite scores as null
+func (b Breakdown) MarshalJSON() ([]byte, error) {
+	type breakdown Breakdown
+
+	return json.Marshal(struct {
+		breakdown
+		Score *float64 `json:"score"`
+	}{
+		breakdown: breakdown(b),
+		Score:     jsonfloat.Finite(b.Score),
+	})
+}
+
+// Load loads a scoring model from a YAML or JSON file
+func Load(filename string) (Model, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return Model{}, err
+	}
+
+	m, err := Parse(data)
+	if err != nil {
+		return Model{}, fmt.Errorf("invalid score config %s: %w", filename, err)
+	}
+
+	return m, nil
+}
+
+// mustParse parses a scoring model and panics on error
+func mustParse(data []byte) Model {
+	m, err := Parse(data)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// Parse parses and validates a scoring model,
+// JSON being a subset of YAML both formats are accepted.
+func Parse(data []byte) (Model, error) {
+	var m Model
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	err := decoder.Decode(&m)
+	if err != nil {
+		return Model{}, err
+	}
+
+	if len(m.Metrics) == 0 {
+		return Model{}, fmt.Errorf("no metric defined")
+	}
+
+	for i := range m.Metrics {
+		sm := &m.Metrics[i]
+		if !backtest.IsMetric(sm.Metric) {
+			return Model{}, fmt.Errorf("unknown metric %q, expecting one of %s", sm.Metric, strings.Join(backtest.MetricNames(), ", "))
+		}
+		if sm.Name == "" {
+			sm.Name = sm.Metric
+		}
+		if sm.Scale == 0 {
+			sm.Scale = 1
+		}
+		if sm.Baseline == 0 {
+			return Model{}, fmt.Errorf("metric %s: baseline must not be 0", sm.Name)
+		}
+		switch sm.Direction {
+		case "":
+			sm.Direction = "higher"
+		case "higher", "lower":
+		default:
+			return Model{}, fmt.Errorf("metric %s: unknown direction %q, expecting higher|lower", sm.Name, sm.Direction)
+		}
+	}
+
+	for _, r := range m.Rules {
+		if !backtest.IsMetric(r.Metric) {
+			return Model{}, fmt.Errorf("rule %s: unknown metric %q", r, r.Metric)
+		}
+		if _, ok := ruleOperators[r.Operator]; !ok {
+			return Model{}, fmt.Errorf("rule %s: unknown operator %q, expecting one of < <= > >= == !=", r, r.Operator)
+		}
+	}
+
+	return m, nil
+}
+
+// metric_score computes the score of a metric
+// using logaritmic growth and exponential decay.
+//
+//   - Logarithmic Growth
+//     log(1+value/baseline): Produces diminishing returns as values increase above the baseline.
+//     This ensures very high values (e.g., Profit Factor = 5) contribute more but don't dominate excessively.
+//
+//   - Exponential Decay for Drawdown:
+//     −log(1+value/baseline): Penalizes drawdowns proportionally.
+//     Larger drawdowns have exponentially higher negative impacts.
+func metric_score(value, baseline float64, positiveDirection bool, sensitivity float64) float64 {
+	if positiveDirection {
+		return sensitivity * math.Log(1+value/baseline)
+	} else {
+		return -sensitivity * math.Log(1+value/baseline)
+	}
+}
@@ -0,0 +1,122 @@
+package score
+
+import (
+	"math"
+	"testing"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+)
+
+// computeScore is the hard-coded scoring formula the default model replaces
+func computeScore(expectancy, profitFactor, drawDown, avgProfit, totalProfit float64) float64 {
+	score := 0.3*metric_score(expectancy, 0.2, true, 2) +
+		0.25*metric_score(profitFactor, 2, true, 1.5) +
+		0.2*metric_score(drawDown, 2, false, 1.2) +
+		0.15*metric_score(avgProfit, 1, true, 1) +
+		0.10*metric_score(totalProfit, 20, true, 1)
+
+	if profitFactor < 1 || expectancy < 0 {
+		score = -1
+	}
+
+	return score
+}
+
+func TestDefaultModel(t *testing.T) {
+	testCases := []struct {
+		name     string
+		strategy backtest.Strategy
+		failed   bool
+	}{
+		{
+			name:     "profitable",
+			strategy: backtest.Strategy{Expectancy: 0.35, ProfitFactor: 1.8, DrawdownRelative: 0.05, ProfitMean: 0.004, ProfitTotal: 0.13},
+		},
+		{
+			name:     "at baseline",
+			strategy: backtest.Strategy{Expectancy: 0.2, ProfitFactor: 2, DrawdownRelative: 0.02, ProfitMean: 0.01, ProfitTotal: 0.2},
+		},
+		{
+			name:     "no drawdown",
+			strategy: backtest.Strategy{Expectancy: 1.2, ProfitFactor: 4, ProfitMean: 0.02, ProfitTotal: 1.5},
+		},
+		{
+			name:     "profit factor below 1",
+			strategy: backtest.Strategy{Expectancy: 0.1, ProfitFactor: 0.9, DrawdownRelative: 0.2, ProfitMean: 0.001, ProfitTotal: 0.01},
+			failed:   true,
+		},
+		{
+			name:     "negative expectancy",
+			strategy: backtest.Strategy{Expectancy: -0.05, ProfitFactor: 1.1, DrawdownRelative: 0.1, ProfitMean: -0.002, ProfitTotal: -0.05},
+			failed:   true,
+		},
+		{
+			name:     "profit factor of exactly 1",
+			strategy: backtest.Strategy{Expectancy: 0, ProfitFactor: 1, DrawdownRelative: 0.1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := tc.strategy
+			expected := computeScore(s.Expectancy, s.ProfitFactor, s.DrawdownRelative*100, s.ProfitMean*100, s.ProfitTotal*100)
+
+			breakdown := Default.Breakdown(s)
+			if math.Abs(breakdown.Score-expected) > 1e-12 {
+				t.Errorf("expected score %v, got %v", expected, breakdown.Score)
+			}
+			if failed := breakdown.FailedRule != ""; failed != tc.failed {
+				t.Errorf("expected failed %v, got %v (%q)", tc.failed, failed, breakdown.FailedRule)
+			}
+		})
+	}
+}
+
+func TestParseFailScore(t *testing.T) {
+	testCases := []struct {
+		name     string
+		config   string
+		expected float64
+	}{
+		{
+			name: "default",
+			config: `
+metrics: [{metric: expectancy, baseline: 0.2}]
+rules: [{metric: profit_factor, operator: "<", value: 1}]
+`,
+			expected: DefaultFailScore,
+		},
+		{
+			name: "explicit zero",
+			config: `
+metrics: [{metric: expectancy, baseline: 0.2}]
+rules: [{metric: profit_factor, operator: "<", value: 1}]
+fail_score: 0
+`,
+			expected: 0,
+		},
+		{
+			name: "custom",
+			config: `
+metrics: [{metric: expectancy, baseline: 0.2}]
+rules: [{metric: profit_factor, operator: "<", value: 1}]
+fail_score: -5
+`,
+			expected: -5,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := Parse([]byte(tc.config))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			score := m.Score(backtest.Strategy{Expectancy: 0.5, ProfitFactor: 0.5})
+			if score != tc.expected {
+				t.Errorf("expected fail score %v, got %v", tc.expected, score)
+			}
+		})
+	}
+}
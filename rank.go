@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/backtest"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/render"
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/report"
+)
+
+// runRank runs the rank command, which scores every strategy of many backtest results
+// and prints a sorted leaderboard.
+func runRank(args []string) error {
+	fs := newFlagSet("rank", "<glob|backtest results directory|result file>...")
+	g := addGlobalFlags(fs, tableFormats...)
+	rf := newReportFlags()
+	rf.addScoreFlags(fs)
+	metric := fs.String("metric", "score", "metric used to rank strategies, score or any scoring model metric")
+	order := fs.String("order", "desc", "ranking order, asc|desc")
+	top := fs.Int("top", 0, "only show the N best strategies, 0 shows all")
+	err := g.parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(g.inputs) < 1 {
+		return fmt.Errorf("expecting at least 1 input got %d", len(g.inputs))
+	}
+
+	if *metric != "score" {
+		_, err := backtest.Strategy{}.Metric(*metric)
+		if err != nil {
+			return err
+		}
+	}
+
+	if *order != "asc" && *order != "desc" {
+		return fmt.Errorf("unknown order %q, expecting asc|desc", *order)
+	}
+
+	scoreModel, err := rf.scoreModel()
+	if err != nil {
+		return err
+	}
+
+	var filenames []string
+	for _, input := range g.inputs {
+		inputFilenames, err := expandRankInput(input)
+		if err != nil {
+			return err
+		}
+		filenames = append(filenames, inputFilenames...)
+	}
+	log.Printf("> ranking %d backtest results\n", len(filenames))
+
+	matched := make(map[string]bool)
+	var entries []report.LeaderboardEntry
+	for _, filename := range filenames {
+		backtestResult, err := g.loadResult(filename, matched)
+		if err != nil {
+			log.Printf("> WARNING: skipping %s: %v\n", filename, err)
+			continue
+		}
+
+		for _, name := range backtestResult.StrategyNames() {
+			s := backtestResult.Strategy[name]
+			strategyScore := scoreModel.Score(s)
+			value := strategyScore
+			if *metric != "score" {
+				value, _ = s.Metric(*metric)
+			}
+
+			// trades are not needed anymore, release them
+			s.Trades = nil
+			entries = append(entries, report.LeaderboardEntry{
+				File:     backtestResult.Name(),
+				Name:     name,
+				Value:    value,
+				Score:    strategyScore,
+				Strategy: s,
+			})
+		}
+	}
+
+	g.warnUnmatched(matched)
+
+	report.SortLeaderboard(entries, *order == "asc")
+	if *top > 0 && len(entries) > *top {
+		entries = entries[:*top]
+	}
+
+	render.PrintLeaderboard(entries, *metric, render.Options{Format: g.format})
+
+	return nil
+}
+
+// expandRankInput returns the backtest result files matching input,
+// which is either a glob pattern, a backtest results directory or a result file.
+// Glob matches are filtered like backtest results directories.
+func expandRankInput(input string) ([]string, error) {
+	info, err := os.Stat(input)
+	if err == nil && info.IsDir() {
+		return backtest.ListResults(input)
+	}
+	if err == nil {
+		return []string{input}, nil
+	}
+
+	matches, err := filepath.Glob(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// globs also match directories, metadata and config files, keep results only
+	var filenames []string
+	for _, match := range matches {
+		info, err := os.Stat(match)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		filenames = append(filenames, match)
+	}
+	filenames = backtest.FilterResults(filenames)
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("no backtest result matching %s", input)
+	}
+
+	return filenames, nil
+}
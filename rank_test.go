@@ -0,0 +1,116 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeFiles creates files, by name, with the given content in dir
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestExpandRankInput(t *testing.T) {
+	files := map[string]string{
+		"backtest-result-2024-01-01_00-00-00.json":        "{}",
+		"backtest-result-2024-02-01_00-00-00.zip":         "",
+		"backtest-result-2024-02-01_00-00-00.json":        "{}",
+		"backtest-result-2024-02-01_00-00-00.meta.json":   "{}",
+		"backtest-result-2024-02-01_00-00-00_config.json": "{}",
+		".last_result.json":                               `{"latest_backtest": "backtest-result-2024-02-01_00-00-00.zip"}`,
+		"my-result.json":                                  "{}",
+	}
+
+	testCases := []struct {
+		name  string
+		input string
+		// expected are file names relative to the test directory
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "directory",
+			input:    ".",
+			expected: []string{"backtest-result-2024-02-01_00-00-00.zip", "backtest-result-2024-01-01_00-00-00.json"},
+		},
+		{
+			name:     "result file",
+			input:    "backtest-result-2024-01-01_00-00-00.json",
+			expected: []string{"backtest-result-2024-01-01_00-00-00.json"},
+		},
+		{
+			name:     "glob",
+			input:    "*.zip",
+			expected: []string{"backtest-result-2024-02-01_00-00-00.zip"},
+		},
+		{
+			name:     "glob skipping metadata and config files",
+			input:    "*.json",
+			expected: []string{"my-result.json", "backtest-result-2024-02-01_00-00-00.json", "backtest-result-2024-01-01_00-00-00.json"},
+		},
+		{
+			name:     "glob keeping the zip of a result",
+			input:    "*",
+			expected: []string{"my-result.json", "backtest-result-2024-02-01_00-00-00.zip", "backtest-result-2024-01-01_00-00-00.json"},
+		},
+		{
+			name:  "glob matching metadata only",
+			input: "*.meta.json",
+			err:   true,
+		},
+		{
+			name:  "glob without match",
+			input: "*.feather",
+			err:   true,
+		},
+		{
+			name:  "invalid glob",
+			input: "[",
+			err:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, files)
+			err := os.Mkdir(filepath.Join(dir, "backtest-result-2024-03-01_00-00-00.json"), 0755)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			filenames, err := expandRankInput(filepath.Join(dir, tc.input))
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected an error, got %v", filenames)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			var expected []string
+			for _, name := range tc.expected {
+				expected = append(expected, filepath.Join(dir, name))
+			}
+			if !reflect.DeepEqual(filenames, expected) {
+				t.Errorf("expected %v, got %v", expected, filenames)
+			}
+		})
+	}
+
+	_, err := expandRankInput(filepath.Join(t.TempDir(), "missing", "*.json"))
+	if err == nil {
+		t.Error("expected an error for a missing directory")
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/TheoBrigitte/freqtrade-backtest-analyzer/pkg/render"
+)
+
+// resultSummary describes a backtest result served by the serve command
+type resultSummary struct {
+	Name       string   `json:"name"`
+	File       string   `json:"file"`
+	Strategies []string `json:"strategies"`
+}
+
+// runServe runs the serve command, which serves the reports of the given backtest results as JSON over HTTP.
+// Inputs are resolved on every request so new results are picked up without restarting.
+func runServe(args []string) error {
+	fs := newFlagSet("serve", "<result file|.last_result.json|backtest results directory>...")
+	g := addGlobalFlags(fs, "json")
+	g.addLatestFlag(fs)
+	rf := newReportFlags()
+	rf.addScoreFlags(fs)
+	rf.addAnalysisFlags(fs)
+	rf.addBootstrapFlags(fs)
+	addr := fs.String("addr", "127.0.0.1:8080", "address to listen on")
+	err := g.parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	opts, err := rf.options()
+	if err != nil {
+		return err
+	}
+
+	// fail early on invalid inputs
+	_, err = g.loadResults()
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok\n"))
+	})
+	mux.HandleFunc("GET /results", func(w http.ResponseWriter, r *http.Request) {
+		results, err := g.loadResults()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		summaries := []resultSummary{}
+		for _, backtestResult := range results {
+			summaries = append(summaries, resultSummary{
+				Name:       backtestResult.Name(),
+				File:       backtestResult.Filename,
+				Strategies: backtestResult.StrategyNames(),
+			})
+		}
+		writeJSON(w, summaries)
+	})
+	mux.HandleFunc("GET /reports", func(w http.ResponseWriter, r *http.Request) {
+		results, err := g.loadResults()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		output := render.JSONOutput{Results: []render.JSONResult{}}
+		for _, backtestResult := range results {
+			output.Results = append(output.Results, jsonResult(backtestResult, opts))
+		}
+		writeJSON(w, output)
+	})
+	mux.HandleFunc("GET /reports/{name}", func(w http.ResponseWriter, r *http.Request) {
+		results, err := g.loadResults()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		for _, backtestResult := range results {
+			if backtestResult.Name() == r.PathValue("name") {
+				writeJSON(w, jsonResult(backtestResult, opts))
+				return
+			}
+		}
+		http.Error(w, "unknown backtest result "+r.PathValue("name"), http.StatusNotFound)
+	})
+
+	log.Printf("> listening on http://%s\n", *addr)
+	return http.ListenAndServe(*addr, mux)
+}
+
+// writeJSON writes v as the JSON response body
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(v)
+	if err != nil {
+		log.Printf("> WARNING: failed to write response: %v\n", err)
+	}
+}